@@ -69,6 +69,8 @@ type HashgardApp struct {
 	accountKeeper       auth.AccountKeeper
 	feeCollectionKeeper auth.FeeCollectionKeeper
 	bankKeeper          bank.Keeper
+	freezeBankKeeper    issue.FreezeBankKeeper
+	tradeBankKeeper     box.TradeBankKeeper
 	stakingKeeper       staking.Keeper
 	slashingKeeper      slashing.Keeper
 	mintKeeper          mint.Keeper
@@ -156,6 +158,9 @@ func NewHashgardApp(logger log.Logger, db dbm.DB, traceStore io.Writer,
 		app.feeCollectionKeeper,
 	)
 
+	// distribution and governance keep the plain bank keeper: the rewards are credited out of the collected fees
+	// and the deposits are refunded or burnt by the end blockers, which can not fail a freeze check.
+	// The fees and the deposits paid in frozen or paused issue coins are rejected by the ante handler instead
 	app.distributionKeeper = distribution.NewKeeper(
 		app.cdc,
 		app.keyDistribution,
@@ -190,23 +195,35 @@ func NewHashgardApp(logger log.Logger, db dbm.DB, traceStore io.Writer,
 		app.paramsKeeper,
 		app.paramsKeeper.Subspace(issue.DefaultParamspace),
 		app.bankKeeper,
+		app.feeCollectionKeeper,
 		issue.DefaultCodespace)
 
+	// enforce issue freezes on every transfer made through the bank keeper,
+	// the allowlists do not apply to the accounts holding coins for the box and exchange users
+	app.freezeBankKeeper = issue.NewFreezeBankKeeper(app.bankKeeper, app.issueKeeper,
+		func(ctx sdk.Context, addr sdk.AccAddress) bool {
+			return app.boxKeeper.IsDepositedCoinsAddress(ctx, addr)
+		},
+		exchange.IsModuleAccount)
+
 	app.boxKeeper = box.NewKeeper(
 		app.cdc,
 		app.keyBox,
 		app.paramsKeeper,
 		app.paramsKeeper.Subspace(box.DefaultParamspace),
-		app.bankKeeper,
+		app.freezeBankKeeper,
 		app.issueKeeper,
 		box.DefaultCodespace)
 
+	// reject transfers of box coins whose trade is disabled
+	app.tradeBankKeeper = box.NewTradeBankKeeper(app.freezeBankKeeper, app.boxKeeper)
+
 	app.exchangeKeeper = exchange.NewKeeper(
 		app.cdc,
 		app.keyExchange,
 		app.paramsKeeper,
 		app.paramsKeeper.Subspace(exchange.DefaultParamspace),
-		app.bankKeeper,
+		app.tradeBankKeeper,
 		exchange.DefaultCodespace,
 	)
 
@@ -228,10 +245,13 @@ func NewHashgardApp(logger log.Logger, db dbm.DB, traceStore io.Writer,
 	bank.RegisterInvariants(&app.crisisKeeper, app.accountKeeper)
 	distribution.RegisterInvariants(&app.crisisKeeper, app.distributionKeeper, app.stakingKeeper)
 	staking.RegisterInvariants(&app.crisisKeeper, app.stakingKeeper, app.feeCollectionKeeper, app.distributionKeeper, app.accountKeeper)
+	issue.RegisterInvariants(&app.crisisKeeper, app.issueKeeper, app.accountKeeper)
+	box.RegisterInvariants(&app.crisisKeeper, app.boxKeeper)
+	exchange.RegisterInvariants(&app.crisisKeeper, app.exchangeKeeper)
 
 	// register message routes
 	app.Router().
-		AddRoute(bank.RouterKey, bank.NewHandler(app.bankKeeper)).
+		AddRoute(bank.RouterKey, bank.NewHandler(app.tradeBankKeeper)).
 		AddRoute(staking.RouterKey, staking.NewHandler(app.stakingKeeper)).
 		AddRoute(distribution.RouterKey, distribution.NewHandler(app.distributionKeeper)).
 		AddRoute(slashing.RouterKey, slashing.NewHandler(app.slashingKeeper)).
@@ -272,7 +292,8 @@ func NewHashgardApp(logger log.Logger, db dbm.DB, traceStore io.Writer,
 	)
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
+	app.SetAnteHandler(issue.NewFreezeAnteHandler(app.issueKeeper,
+		auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper)))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -321,6 +342,9 @@ func (app *HashgardApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock
 	// TODO: This should really happen at EndBlocker.
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
 
+	// move the exchange orders of an older store layout to the indexed layout before any tx reads them
+	exchange.BeginBlocker(ctx, app.exchangeKeeper)
+
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
 	}
@@ -335,6 +359,8 @@ func (app *HashgardApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 	tags = append(tags, endBlockerTags...)
 	boxTags := box.EndBlocker(ctx, app.boxKeeper)
 	tags = append(tags, boxTags...)
+	exchangeTags := exchange.EndBlocker(ctx, app.exchangeKeeper)
+	tags = append(tags, exchangeTags...)
 
 	if app.invCheckPeriod != 0 && ctx.BlockHeight()%int64(app.invCheckPeriod) == 0 {
 		app.assertRuntimeInvariants()
@@ -351,9 +351,15 @@ func HashgardValidateGenesisState(genesisState GenesisState) error {
 	if err := issue.ValidateGenesis(genesisState.IssueData); err != nil {
 		return err
 	}
+	if err := validateGenesisStateIssueSupply(genesisState); err != nil {
+		return err
+	}
 	if err := box.ValidateGenesis(genesisState.BoxData); err != nil {
 		return err
 	}
+	if err := validateGenesisStateBoxDepositedCoins(genesisState); err != nil {
+		return err
+	}
 	if err := exchange.ValidateGenesis(genesisState.ExchangeData); err != nil {
 		return err
 	}
@@ -399,6 +405,26 @@ func validateGenesisStateAccounts(accs []GenesisAccount) (err error) {
 	return nil
 }
 
+// validateGenesisStateIssueSupply ensures that the total supply of every issue
+// equals the balances of that issue held by the genesis accounts.
+func validateGenesisStateIssueSupply(genesisState GenesisState) error {
+	balances := make([]sdk.Coins, len(genesisState.Accounts))
+	for i, acc := range genesisState.Accounts {
+		balances[i] = acc.Coins
+	}
+	return issue.ValidateGenesisSupply(genesisState.IssueData, balances)
+}
+
+// validateGenesisStateBoxDepositedCoins ensures that the account holding the
+// deposited coins of every box holds the coins recorded by that box.
+func validateGenesisStateBoxDepositedCoins(genesisState GenesisState) error {
+	balances := make(map[string]sdk.Coins, len(genesisState.Accounts))
+	for _, acc := range genesisState.Accounts {
+		balances[acc.Address.String()] = acc.Coins
+	}
+	return box.ValidateGenesisDepositedCoins(genesisState.BoxData, balances)
+}
+
 // CollectStdTxs processes and validates application's genesis StdTxs and returns
 // the list of appGenTxs, and persistent peers required to generate genesis.json.
 func CollectStdTxs(cdc *codec.Codec, moniker string, genTxsDir string, genDoc tmtypes.GenesisDoc) (
@@ -285,9 +285,16 @@ func addExchangeCmd(cdc *codec.Codec, rootCmd *cobra.Command) {
 	}
 	exchangeCmd.AddCommand(
 		client.GetCommands(
+			exchangecmd.GetCmdQueryParams(exchange.StoreKey, cdc),
 			exchangecmd.GetCmdQueryOrder(exchange.StoreKey, cdc),
 			exchangecmd.GetCmdQueryOrdersByAddr(exchange.StoreKey, cdc),
 			exchangecmd.GetCmdFrozenFund(exchange.StoreKey, cdc),
+			exchangecmd.GetCmdQueryDepth(exchange.StoreKey, cdc),
+			exchangecmd.GetCmdQueryTrades(exchange.StoreKey, cdc),
+			exchangecmd.GetCmdQueryCandles(exchange.StoreKey, cdc),
+			exchangecmd.GetCmdQueryPool(exchange.StoreKey, cdc),
+			exchangecmd.GetCmdQueryPools(exchange.StoreKey, cdc),
+			exchangecmd.GetCmdQueryQuote(exchange.StoreKey, cdc),
 		)...)
 	exchangeCmd.AddCommand(client.LineBreak)
 	exchangeCmd.AddCommand(
@@ -295,6 +302,10 @@ func addExchangeCmd(cdc *codec.Codec, rootCmd *cobra.Command) {
 			exchangecmd.GetCmdCreateOrder(cdc),
 			exchangecmd.GetCmdWithdrawalOrder(cdc),
 			exchangecmd.GetCmdTakeOrder(cdc),
+			exchangecmd.GetCmdCreatePool(cdc),
+			exchangecmd.GetCmdAddLiquidity(cdc),
+			exchangecmd.GetCmdRemoveLiquidity(cdc),
+			exchangecmd.GetCmdSwap(cdc),
 		)...)
 	rootCmd.AddCommand(exchangeCmd)
 }
@@ -8,15 +8,21 @@ import (
 )
 
 type (
-	Keeper  = keeper.Keeper
-	BoxInfo = types.BoxInfo
+	Keeper            = keeper.Keeper
+	BankKeeper        = keeper.BankKeeper
+	TradeBankKeeper   = keeper.TradeBankKeeper
+	BoxInfo           = types.BoxInfo
+	AddressBoxDeposit = types.AddressBoxDeposit
+	BoxQueueItem      = types.BoxQueueItem
+	BoxFailure        = types.BoxFailure
 )
 
 var (
-	MsgCdc          = msgs.MsgCdc
-	NewKeeper       = keeper.NewKeeper
-	NewModuleClient = client.NewModuleClient
-	RegisterCodec   = msgs.RegisterCodec
+	MsgCdc             = msgs.MsgCdc
+	NewKeeper          = keeper.NewKeeper
+	NewTradeBankKeeper = keeper.NewTradeBankKeeper
+	NewModuleClient    = client.NewModuleClient
+	RegisterCodec      = msgs.RegisterCodec
 )
 
 const (
@@ -13,4 +13,14 @@ const (
 	flagEstablishTime = "establish-time"
 	flagMaturityTime  = "maturity-time"
 	flagMiniMultiple  = "mini-multiple"
+	flagEarlyWithdraw = "early-withdraw"
+	flagPenaltyRate   = "penalty-rate"
+	flagAutoClaim     = "auto-claim"
+
+	flagCliffTime = "cliff-time"
+	flagPeriod    = "period"
+	flagRevocable = "revocable"
+
+	flagSoftCap = "soft-cap"
+	flagHardCap = "hard-cap"
 )
@@ -48,8 +48,8 @@ func GetCmdQueryDepositBoxDeposit(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "query-deposit [box-id]",
 		Args:    cobra.ExactArgs(1),
-		Short:   "Query deposit list from deposit box",
-		Long:    "Query deposit list from deposit box",
+		Short:   "Query deposit list from deposit or sale box",
+		Long:    "Query deposit list from deposit or sale box",
 		Example: "$ hashgardcli box query-deposit boxab3jlxpt2ps",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -65,7 +65,7 @@ func GetCmdQueryDepositBoxDeposit(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			if boxInfo.GetBoxType() != types.Deposit {
+			if boxInfo.GetBoxType() != types.Deposit && boxInfo.GetBoxType() != types.Sale {
 				return errors.Errorf(errors.ErrNotSupportOperation())
 			}
 			if boxInfo.GetBoxStatus() == types.BoxCreated {
@@ -98,6 +98,120 @@ func GetCmdQueryDepositBoxDeposit(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryUnclaimed implements the query unclaimed amount of a deposit box command.
+func GetCmdQueryUnclaimed(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-unclaimed [box-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the unclaimed amount of a matured deposit box",
+		Long:    "Query the principal and interest not yet claimed by the depositors of a matured deposit box",
+		Example: "$ hashgardcli box query-unclaimed boxab3jlxpt2ps",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			boxID := args[0]
+			if err := boxutils.CheckBoxId(boxID); err != nil {
+				return errors.Errorf(err)
+			}
+			address, err := sdk.AccAddressFromBech32(viper.GetString(flagAddress))
+			if err != nil {
+				return err
+			}
+			boxQueryParams := params.BoxQueryDepositListParams{
+				BoxId: boxID,
+				Owner: address,
+			}
+			// Query the box
+			res, err := boxqueriers.QueryUnclaimed(boxQueryParams, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+			var list types.DepositBoxUnclaimedList
+			cdc.MustUnmarshalJSON(res, &list)
+
+			return cliCtx.PrintOutput(list)
+		},
+	}
+	cmd.Flags().String(flagAddress, "", "Depositor address")
+	return cmd
+}
+
+// GetCmdQueryFailed implements the query failed boxes command.
+func GetCmdQueryFailed(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "query-failed",
+		Args:    cobra.NoArgs,
+		Short:   "Query the boxes the end blocker failed to process",
+		Long:    "Query the failed entries of the box queue with the height and reason of the failure, the box owner can retry them",
+		Example: "$ hashgardcli box query-failed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			// Query the failed boxes
+			res, err := boxqueriers.QueryFailed(cliCtx)
+			if err != nil {
+				return err
+			}
+			var failures types.BoxFailures
+			cdc.MustUnmarshalJSON(res, &failures)
+
+			return cliCtx.PrintOutput(failures)
+		},
+	}
+}
+
+// GetCmdQueryClaimable implements the query claimable amount of a vesting box command.
+func GetCmdQueryClaimable(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "query-claimable [box-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the claimable amount of a vesting box",
+		Long:    "Query the vested, claimed and claimable amount of a vesting box at the latest block time",
+		Example: "$ hashgardcli box query-claimable boxad3jlxpt2ps",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			boxID := args[0]
+			if err := boxutils.CheckBoxId(boxID); err != nil {
+				return errors.Errorf(err)
+			}
+			// Query the box
+			res, err := boxqueriers.QueryClaimable(boxID, cliCtx)
+			if err != nil {
+				return err
+			}
+			var claimable types.VestingBoxClaimable
+			cdc.MustUnmarshalJSON(res, &claimable)
+
+			return cliCtx.PrintOutput(claimable)
+		},
+	}
+}
+
+// GetCmdQueryEscrow implements the query escrow boxes of a party command.
+func GetCmdQueryEscrow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "query-escrow [address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query escrow boxes of a beneficiary or an arbiter",
+		Long:    "Query the escrow boxes in which the address is the beneficiary or the arbiter, use list-box escrow for the boxes of an owner",
+		Example: "$ hashgardcli box query-escrow gard1hf4n743fujvxrwx8af7u35anjqpdd2cx8p6cdd",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			// Query the box
+			res, err := boxqueriers.QueryEscrow(address, cliCtx)
+			if err != nil {
+				return err
+			}
+			var boxs types.BoxInfos
+			cdc.MustUnmarshalJSON(res, &boxs)
+
+			return cliCtx.PrintOutput(utils.GetBoxList(cdc, cliCtx, boxs, types.Escrow))
+		},
+	}
+}
+
 // GetCmdQueryBox implements the query box command.
 func GetCmdQueryBoxs(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
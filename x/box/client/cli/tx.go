@@ -9,13 +9,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/hashgard/hashgard/x/box/client/queriers"
 	clientutils "github.com/hashgard/hashgard/x/box/client/utils"
 	"github.com/hashgard/hashgard/x/box/errors"
 	"github.com/hashgard/hashgard/x/box/msgs"
 	"github.com/hashgard/hashgard/x/box/types"
 	boxutils "github.com/hashgard/hashgard/x/box/utils"
-	issueutils "github.com/hashgard/hashgard/x/issue/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -94,7 +92,7 @@ func GetCmdBoxDisableFeature(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			if feature == types.Trade && boxInfo.GetBoxType() == types.Lock {
+			if feature == types.Trade && !types.IsTradable(boxInfo.GetBoxType()) {
 				return errors.Errorf(errors.ErrNotSupportOperation())
 			}
 
@@ -109,6 +107,39 @@ func GetCmdBoxDisableFeature(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdBoxRetry implements retry the failed processing of a box transaction command.
+func GetCmdBoxRetry(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "retry [box-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Retry a box the end blocker failed to process",
+		Long:    "Box owner or the retry authority of the box params processes again the failed entries of a box, see query-failed for the failed boxes and their reasons",
+		Example: "$ hashgardcli box retry boxab3jlxpt2ps --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boxID := args[0]
+			if err := boxutils.CheckBoxId(boxID); err != nil {
+				return errors.Errorf(err)
+			}
+			txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+			if err != nil {
+				return err
+			}
+			// the owner or the retry authority of the params may retry, checked by the keeper
+			if _, err = boxutils.GetBoxByID(cdc, cliCtx, boxID); err != nil {
+				return err
+			}
+
+			msg := msgs.NewMsgBoxRetry(boxID, account.GetAddress())
+			validateErr := msg.ValidateBasic()
+			if validateErr != nil {
+				return errors.Errorf(validateErr)
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return cmd
+}
+
 // GetCmdDepositToBox implements deposit to a box transaction command.
 func GetCmdDepositToBox(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -139,80 +170,17 @@ func GetCmdFetchDepositFromBox(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 func deposit(cdc *codec.Codec, args []string, operation string) error {
-
-	boxID := args[0]
-	if err := boxutils.CheckBoxId(boxID); err != nil {
-		return errors.Errorf(err)
-	}
-	amountArg, ok := sdk.NewIntFromString(args[1])
+	amount, ok := sdk.NewIntFromString(args[1])
 	if !ok {
-		return fmt.Errorf("Amount %s not a valid int, please input a valid amount", args[2])
+		return fmt.Errorf("Amount %s not a valid int, please input a valid amount", args[1])
 	}
 	txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
 	if err != nil {
 		return err
 	}
-	boxInfo, err := boxutils.GetBoxByID(cdc, cliCtx, boxID)
-	if err != nil {
-		return err
-	}
-	if boxInfo.GetBoxStatus() != types.BoxDepositing {
-		return errors.Errorf(errors.ErrNotAllowedOperation(boxInfo.GetBoxStatus()))
-	}
-	issueInfo, err := issueutils.GetIssueByID(cdc, cliCtx, boxInfo.GetTotalAmount().Token.Denom)
+	msg, err := clientutils.GetDepositMsg(cdc, cliCtx, account, args[0], amount, operation, true)
 	if err != nil {
 		return err
 	}
-	amount := issueutils.MulDecimals(amountArg, issueInfo.GetDecimals())
-
-	switch operation {
-	case types.DepositTo:
-		if err = checkAmountByDepositTo(amount, boxInfo); err != nil {
-			return err
-		}
-	case types.Fetch:
-		res, err := queriers.QueryDepositAmountFromDepositBox(boxID, account.GetAddress(), cliCtx)
-		if err == nil {
-			var depositAmount sdk.Int
-			cdc.MustUnmarshalJSON(res, &depositAmount)
-			if depositAmount.LT(amount) {
-				return errors.Errorf(errors.ErrNotEnoughAmount())
-			}
-		}
-	default:
-		return errors.ErrNotSupportOperation()
-	}
-	msg := msgs.NewMsgBoxDeposit(boxID, account.GetAddress(), sdk.NewCoin(boxInfo.GetTotalAmount().Token.Denom, amount), operation)
-
-	validateErr := msg.ValidateBasic()
-	if validateErr != nil {
-		return errors.Errorf(validateErr)
-	}
 	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
-
-}
-
-func checkAmountByDepositTo(amount sdk.Int, boxInfo types.Box) error {
-	switch boxInfo.GetBoxType() {
-	case types.Deposit:
-		if !amount.Mod(boxInfo.GetDeposit().Price).IsZero() {
-			return errors.ErrAmountNotValid(amount.String())
-		}
-		if amount.Add(boxInfo.GetDeposit().TotalDeposit).GT(boxInfo.GetTotalAmount().Token.Amount) {
-			return errors.Errorf(errors.ErrNotEnoughAmount())
-		}
-	case types.Future:
-		total := sdk.ZeroInt()
-		if boxInfo.GetFuture().Deposits != nil {
-			for _, v := range boxInfo.GetFuture().Deposits {
-				total = total.Add(v.Amount)
-			}
-		}
-		if amount.Add(total).GT(boxInfo.GetTotalAmount().Token.Amount) {
-			return errors.Errorf(errors.ErrNotEnoughAmount())
-		}
-	default:
-		return errors.Errorf(errors.ErrNotSupportOperation())
-	}
-	return nil
 }
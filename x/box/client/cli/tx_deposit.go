@@ -38,23 +38,25 @@ func GetCmdDepositBoxCreate(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			issueInfo, err := issueutils.GetIssueByID(cdc, cliCtx, coin.Denom)
+			decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, coin.Denom)
 			if err != nil {
 				return err
 			}
 
-			coin.Amount = issueutils.MulDecimals(coin.Amount, issueInfo.GetDecimals())
+			coin.Amount = issueutils.MulDecimals(coin.Amount, decimals)
 
 			box := params.BoxDepositParams{}
 
 			box.Sender = account.GetAddress()
 			box.Name = args[0]
 			box.BoxType = types.Deposit
-			box.TotalAmount = types.BoxToken{Token: coin, Decimals: issueInfo.GetDecimals()}
+			box.TotalAmount = types.BoxToken{Token: coin, Decimals: decimals}
 			box.TradeDisabled = viper.GetBool(flagTradeDisabled)
 			box.Deposit = types.DepositBox{
 				Share:         sdk.ZeroInt(),
 				TotalDeposit:  sdk.ZeroInt(),
+				Penalty:       sdk.ZeroInt(),
+				Claimed:       sdk.ZeroInt(),
 				StartTime:     viper.GetInt64(flagStartTime),
 				EstablishTime: viper.GetInt64(flagEstablishTime),
 				MaturityTime:  viper.GetInt64(flagMaturityTime)}
@@ -69,8 +71,8 @@ func GetCmdDepositBoxCreate(cdc *codec.Codec) *cobra.Command {
 				return errors.Errorf(errors.ErrAmountNotValid(flagPrice))
 			}
 			box.Deposit.Price = num
-			box.Deposit.Price = issueutils.MulDecimals(box.Deposit.Price, issueInfo.GetDecimals())
-			box.Deposit.BottomLine = issueutils.MulDecimals(box.Deposit.BottomLine, issueInfo.GetDecimals())
+			box.Deposit.Price = issueutils.MulDecimals(box.Deposit.Price, decimals)
+			box.Deposit.BottomLine = issueutils.MulDecimals(box.Deposit.BottomLine, decimals)
 
 			interest, err := sdk.ParseCoin(viper.GetString(flagInterest))
 			if err != nil {
@@ -79,13 +81,20 @@ func GetCmdDepositBoxCreate(cdc *codec.Codec) *cobra.Command {
 
 			issueInfo, err = issueutils.GetIssueByID(cdc, cliCtx, interest.Denom)
 			if err == nil {
-				interest.Amount = issueutils.MulDecimals(interest.Amount, issueInfo.GetDecimals())
-				box.Deposit.Interest = types.BoxToken{Token: interest, Decimals: issueInfo.GetDecimals()}
+				interest.Amount = issueutils.MulDecimals(interest.Amount, decimals)
+				box.Deposit.Interest = types.BoxToken{Token: interest, Decimals: decimals}
 			}
 
 			box.Deposit.PerCoupon = boxutils.CalcInterestRate(box.TotalAmount.Token.Amount, box.Deposit.Price,
 				box.Deposit.Interest.Token.Amount, box.Deposit.Interest.Decimals)
 
+			box.Deposit.EarlyWithdraw = viper.GetBool(flagEarlyWithdraw)
+			box.Deposit.AutoClaim = viper.GetBool(flagAutoClaim)
+			box.Deposit.PenaltyRate, err = sdk.NewDecFromStr(viper.GetString(flagPenaltyRate))
+			if err != nil {
+				return errors.Errorf(errors.ErrAmountNotValid(flagPenaltyRate))
+			}
+
 			msg := msgs.NewMsgDepositBox(&box)
 			validateErr := msg.ValidateBasic()
 
@@ -102,6 +111,9 @@ func GetCmdDepositBoxCreate(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Int64(flagStartTime, 0, "Box start time")
 	cmd.Flags().Int64(flagEstablishTime, 0, "Box establish time")
 	cmd.Flags().Int64(flagMaturityTime, 0, "Box maturity time")
+	cmd.Flags().Bool(flagEarlyWithdraw, false, "Allow depositors to withdraw before the maturity time")
+	cmd.Flags().String(flagPenaltyRate, "0", "Box early withdrawal penalty rate of the principal")
+	cmd.Flags().Bool(flagAutoClaim, false, "Pay the depositors at maturity without waiting for their claims")
 
 	return cmd
 }
@@ -136,65 +148,47 @@ func GetCmdDepositBoxInterestFetch(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
-func interest(cdc *codec.Codec, args []string, operation string) error {
-	boxID := args[0]
-	if err := boxutils.CheckBoxId(boxID); err != nil {
-		return errors.Errorf(err)
+// GetCmdDepositBoxWithdraw implements withdraw from a deposit box before maturity transaction command.
+func GetCmdDepositBoxWithdraw(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [box-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw principal from a deposit box before maturity",
+		Long: "Withdraw principal from a deposit box allowing early withdrawal before the maturity time. " +
+			"The interest of the withdrawn amount and the penalty are forfeited to the remaining depositors",
+		Example: "$ hashgardcli box withdraw boxab3jlxpt2ps 10000 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("Amount %s not a valid int, please input a valid amount", args[1])
+			}
+			txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+			if err != nil {
+				return err
+			}
+			msg, err := clientutils.GetWithdrawMsg(cdc, cliCtx, account, args[0], amount, true)
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
 	}
-	amountArg, ok := sdk.NewIntFromString(args[1])
+	return cmd
+}
+
+func interest(cdc *codec.Codec, args []string, operation string) error {
+	amount, ok := sdk.NewIntFromString(args[1])
 	if !ok {
-		return fmt.Errorf("Amount %s not a valid int, please input a valid amount", args[2])
+		return fmt.Errorf("Amount %s not a valid int, please input a valid amount", args[1])
 	}
 	txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
 	if err != nil {
 		return err
 	}
-	box, err := boxutils.GetBoxByID(cdc, cliCtx, boxID)
+	msg, err := clientutils.GetInterestMsg(cdc, cliCtx, account, args[0], amount, operation, true)
 	if err != nil {
 		return err
 	}
-	if box.GetBoxType() != types.Deposit {
-		return errors.Errorf(errors.ErrNotSupportOperation())
-	}
-	if box.GetBoxStatus() != types.BoxCreated {
-		return errors.Errorf(errors.ErrNotSupportOperation())
-	}
-	issueInfo, err := issueutils.GetIssueByID(cdc, cliCtx, box.GetDeposit().Interest.Token.Denom)
-	if err != nil {
-		return err
-	}
-	amount := issueutils.MulDecimals(amountArg, issueInfo.GetDecimals())
-	if types.Fetch == operation {
-		flag := true
-		for i, v := range box.GetDeposit().InterestInjections {
-			if v.Address.Equals(account.GetAddress()) {
-				if box.GetDeposit().InterestInjections[i].Amount.GTE(amount) {
-					flag = false
-					break
-				}
-			}
-		}
-		if flag {
-			return errors.ErrNotEnoughAmount()
-		}
-	} else {
-		if box.GetDeposit().InterestInjections != nil {
-			totalInterest := sdk.ZeroInt()
-			for _, v := range box.GetDeposit().InterestInjections {
-				if v.Address.Equals(account.GetAddress()) {
-					totalInterest = totalInterest.Add(v.Amount)
-				}
-			}
-			if totalInterest.Add(amount).GT(box.GetDeposit().Interest.Token.Amount) {
-				return errors.Errorf(errors.ErrInterestInjectionNotValid(sdk.NewCoin(box.GetDeposit().Interest.Token.Denom, amountArg)))
-			}
-		}
-	}
-	msg := msgs.NewMsgBoxInterest(boxID, account.GetAddress(), sdk.NewCoin(box.GetDeposit().Interest.Token.Denom, amount), operation)
-	validateErr := msg.ValidateBasic()
-	if validateErr != nil {
-		return errors.Errorf(validateErr)
-	}
 	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
 }
 
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/hashgard/hashgard/x/box/params"
+
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clientutils "github.com/hashgard/hashgard/x/box/client/utils"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
+)
+
+// GetCmdEscrowBoxCreate implements create escrow box transaction command.
+func GetCmdEscrowBoxCreate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-escrow [name] [total-amount] [beneficiary] [arbiter] [expire-time]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Create a new escrow box",
+		Long: "Create a new escrow box funded by the sender. The arbiter or the confirmation of the beneficiary releases it " +
+			"to the beneficiary, the arbiter refunds it to the sender, and it is refunded to the sender at the expire time",
+		Example: "$ hashgardcli box create-escrow foocoin 100000000coin174876e800 gard1hf4n743fujvxrwx8af7u35anjqpdd2cx8p6cdd gard1lgs73mwr56u2f4z4yz36w8mf7ym50e7myrqn65 2557223200 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// parse coins trying to be sent
+			coin, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			beneficiary, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			arbiter, err := sdk.AccAddressFromBech32(args[3])
+			if err != nil {
+				return err
+			}
+			expireTime, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+			if err != nil {
+				return err
+			}
+
+			decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, coin.Denom)
+			if err != nil {
+				return err
+			}
+
+			coin.Amount = issueutils.MulDecimals(coin.Amount, decimals)
+
+			box := &params.BoxEscrowParams{}
+			box.Sender = account.GetAddress()
+			box.Name = args[0]
+			box.BoxType = types.Escrow
+			box.TotalAmount = types.BoxToken{Token: coin, Decimals: decimals}
+			box.Escrow = types.EscrowBox{Beneficiary: beneficiary, Arbiter: arbiter, ExpireTime: expireTime}
+
+			msg := msgs.NewMsgEscrowBox(box)
+
+			validateErr := msg.ValidateBasic()
+			if validateErr != nil {
+				return errors.Errorf(validateErr)
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return cmd
+}
+
+// GetCmdEscrowBoxRelease implements release an escrow box transaction command.
+func GetCmdEscrowBoxRelease(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release [box-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Release an escrow box to the beneficiary",
+		Long:    "The arbiter of an escrow box, or its beneficiary confirming the deal, releases the escrowed amount to the beneficiary",
+		Example: "$ hashgardcli box release boxae3jlxpt2ps --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return escrow(cdc, args[0], types.Release)
+		},
+	}
+	return cmd
+}
+
+// GetCmdEscrowBoxRefund implements refund an escrow box transaction command.
+func GetCmdEscrowBoxRefund(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refund [box-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Refund an escrow box to the owner",
+		Long:    "The arbiter of an escrow box refunds the escrowed amount to the owner, it is refunded anyway at the expire time",
+		Example: "$ hashgardcli box refund boxae3jlxpt2ps --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return escrow(cdc, args[0], types.Refund)
+		},
+	}
+	return cmd
+}
+
+func escrow(cdc *codec.Codec, boxID string, operation string) error {
+	if err := boxutils.CheckBoxId(boxID); err != nil {
+		return errors.Errorf(err)
+	}
+	txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+	if err != nil {
+		return err
+	}
+	boxInfo, err := boxutils.GetBoxByID(cdc, cliCtx, boxID)
+	if err != nil {
+		return err
+	}
+	if boxInfo.GetBoxType() != types.Escrow {
+		return errors.Errorf(errors.ErrNotSupportOperation())
+	}
+	if boxInfo.GetBoxStatus() != types.BoxActived {
+		return errors.Errorf(errors.ErrNotAllowedOperation(boxInfo.GetBoxStatus()))
+	}
+
+	var msg sdk.Msg
+	switch operation {
+	case types.Release:
+		if !boxInfo.GetEscrow().CanRelease(account.GetAddress()) {
+			return errors.Errorf(errors.ErrEscrowPartyMismatch(boxID, operation))
+		}
+		msg = msgs.NewMsgBoxRelease(boxID, account.GetAddress())
+	default:
+		if !boxInfo.GetEscrow().CanRefund(account.GetAddress()) {
+			return errors.Errorf(errors.ErrEscrowPartyMismatch(boxID, operation))
+		}
+		msg = msgs.NewMsgBoxRefund(boxID, account.GetAddress())
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return errors.Errorf(err)
+	}
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+}
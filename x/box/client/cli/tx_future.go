@@ -13,6 +13,7 @@ import (
 	"github.com/hashgard/hashgard/x/box/errors"
 	"github.com/hashgard/hashgard/x/box/msgs"
 	"github.com/hashgard/hashgard/x/box/types"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
 	issueutils "github.com/hashgard/hashgard/x/issue/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -36,7 +37,7 @@ func GetCmdFutureBoxCreate(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			issueInfo, err := issueutils.GetIssueByID(cdc, cliCtx, coin.Denom)
+			decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, coin.Denom)
 			if err != nil {
 				return err
 			}
@@ -49,15 +50,15 @@ func GetCmdFutureBoxCreate(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			if err = processFutureBox(coin, futureBox, issueInfo.GetDecimals()); err != nil {
+			if err = processFutureBox(coin, futureBox, decimals); err != nil {
 				return err
 			}
-			coin.Amount = issueutils.MulDecimals(coin.Amount, issueInfo.GetDecimals())
+			coin.Amount = issueutils.MulDecimals(coin.Amount, decimals)
 			box := params.BoxFutureParams{}
 			box.Sender = account.GetAddress()
 			box.Name = args[0]
 			box.BoxType = types.Future
-			box.TotalAmount = types.BoxToken{Token: coin, Decimals: issueInfo.GetDecimals()}
+			box.TotalAmount = types.BoxToken{Token: coin, Decimals: decimals}
 			box.TradeDisabled = viper.GetBool(flagTradeDisabled)
 			box.Future = futureBox
 			box.Future.MiniMultiple = uint(viper.GetInt(flagMiniMultiple))
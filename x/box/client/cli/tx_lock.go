@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clientutils "github.com/hashgard/hashgard/x/box/client/utils"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
 	issueutils "github.com/hashgard/hashgard/x/issue/utils"
 	"github.com/spf13/cobra"
 
@@ -37,7 +38,7 @@ func GetCmdLockBoxCreate(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			issueInfo, err := issueutils.GetIssueByID(cdc, cliCtx, coin.Denom)
+			decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, coin.Denom)
 			if err != nil {
 				return err
 			}
@@ -47,13 +48,13 @@ func GetCmdLockBoxCreate(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			coin.Amount = issueutils.MulDecimals(coin.Amount, issueInfo.GetDecimals())
+			coin.Amount = issueutils.MulDecimals(coin.Amount, decimals)
 
 			box := &params.BoxLockParams{}
 			box.Sender = account.GetAddress()
 			box.Name = args[0]
 			box.BoxType = types.Lock
-			box.TotalAmount = types.BoxToken{Token: coin, Decimals: issueInfo.GetDecimals()}
+			box.TotalAmount = types.BoxToken{Token: coin, Decimals: decimals}
 			box.Lock = types.LockBox{EndTime: endTime}
 
 			msg := msgs.NewMsgLockBox(box)
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/hashgard/hashgard/x/box/params"
+
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clientutils "github.com/hashgard/hashgard/x/box/client/utils"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// GetCmdSaleBoxCreate implements create sale box transaction command.
+func GetCmdSaleBoxCreate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-sale [name] [total-amount] [price] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Create a new sale box",
+		Long: "Create a new sale box selling the total amount at a price for one token. Buyers deposit the price denom " +
+			"from the start time to the end time. If the deposits reach the soft cap the tokens are distributed pro-rata " +
+			"up to the hard cap and the proceeds go to the owner, otherwise every deposit is refunded",
+		Example: "$ hashgardcli box create-sale foocoin 100000000coin174876e800 10gard 1557223200 1558223200 --soft-cap 100000000 --hard-cap 1000000000 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// parse coins trying to be sent
+			coin, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			price, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+			startTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			endTime, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			softCap, ok := sdk.NewIntFromString(viper.GetString(flagSoftCap))
+			if !ok {
+				return errors.Errorf(errors.ErrAmountNotValid(flagSoftCap))
+			}
+			hardCap, ok := sdk.NewIntFromString(viper.GetString(flagHardCap))
+			if !ok {
+				return errors.Errorf(errors.ErrAmountNotValid(flagHardCap))
+			}
+
+			txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+			if err != nil {
+				return err
+			}
+
+			decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, coin.Denom)
+			if err != nil {
+				return err
+			}
+
+			coin.Amount = issueutils.MulDecimals(coin.Amount, decimals)
+
+			priceDecimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, price.Denom)
+			if err != nil {
+				return err
+			}
+			price.Amount = issueutils.MulDecimals(price.Amount, priceDecimals)
+			softCap = issueutils.MulDecimals(softCap, priceDecimals)
+			hardCap = issueutils.MulDecimals(hardCap, priceDecimals)
+
+			box := &params.BoxSaleParams{}
+			box.Sender = account.GetAddress()
+			box.Name = args[0]
+			box.BoxType = types.Sale
+			box.TotalAmount = types.BoxToken{Token: coin, Decimals: decimals}
+			box.Sale = types.SaleBox{
+				Price:        price,
+				StartTime:    startTime,
+				EndTime:      endTime,
+				SoftCap:      softCap,
+				HardCap:      hardCap,
+				TotalDeposit: sdk.ZeroInt(),
+				Raised:       sdk.ZeroInt(),
+				Sold:         sdk.ZeroInt()}
+
+			msg := msgs.NewMsgSaleBox(box)
+
+			validateErr := msg.ValidateBasic()
+			if validateErr != nil {
+				return errors.Errorf(validateErr)
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	cmd.Flags().String(flagSoftCap, "", "Box soft cap, the least payment in the price denom for the sale to succeed")
+	cmd.Flags().String(flagHardCap, "", "Box hard cap, the most payment in the price denom accepted by the sale")
+	return cmd
+}
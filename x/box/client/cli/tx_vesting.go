@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/hashgard/hashgard/x/box/params"
+
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clientutils "github.com/hashgard/hashgard/x/box/client/utils"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
+)
+
+// GetCmdVestingBoxCreate implements create vesting box transaction command.
+func GetCmdVestingBoxCreate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting [name] [total-amount] [beneficiary] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Create a new vesting box",
+		Long: "Create a new vesting box, the total amount is released to the beneficiary from the start time to the end time. " +
+			"Nothing is released before the cliff time, and the release is linear unless a period in seconds is given",
+		Example: "$ hashgardcli box create-vesting foocoin 100000000coin174876e800 gard1hf4n743fujvxrwx8af7u35anjqpdd2cx8p6cdd 1557223200 1588845600 --cliff-time 1565172000 --period 2592000 --revocable --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// parse coins trying to be sent
+			coin, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			beneficiary, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+			startTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			endTime, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+			if err != nil {
+				return err
+			}
+
+			decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, coin.Denom)
+			if err != nil {
+				return err
+			}
+
+			coin.Amount = issueutils.MulDecimals(coin.Amount, decimals)
+
+			box := &params.BoxVestingParams{}
+			box.Sender = account.GetAddress()
+			box.Name = args[0]
+			box.BoxType = types.Vesting
+			box.TotalAmount = types.BoxToken{Token: coin, Decimals: decimals}
+			box.Vesting = types.VestingBox{
+				Beneficiary: beneficiary,
+				StartTime:   startTime,
+				CliffTime:   viper.GetInt64(flagCliffTime),
+				EndTime:     endTime,
+				Period:      viper.GetInt64(flagPeriod),
+				Revocable:   viper.GetBool(flagRevocable),
+				Claimed:     sdk.ZeroInt()}
+
+			msg := msgs.NewMsgVestingBox(box)
+
+			validateErr := msg.ValidateBasic()
+			if validateErr != nil {
+				return errors.Errorf(validateErr)
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	cmd.Flags().Int64(flagCliffTime, 0, "Box cliff time, nothing is released before it")
+	cmd.Flags().Int64(flagPeriod, 0, "Box release period in seconds, 0 releases linearly")
+	cmd.Flags().Bool(flagRevocable, false, "Owner can revoke the unvested amount")
+	return cmd
+}
+
+// GetCmdVestingBoxClaim implements claim from a vesting or a matured deposit box transaction command.
+func GetCmdVestingBoxClaim(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim [box-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Claim the vested amount from a vesting box or the payout of a matured deposit box",
+		Long:    "Beneficiary claims all of the vested and unclaimed amount from a vesting box, depositor claims the principal and interest of a matured deposit box",
+		Example: "$ hashgardcli box claim boxad3jlxpt2ps --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boxID := args[0]
+			if err := boxutils.CheckBoxId(boxID); err != nil {
+				return errors.Errorf(err)
+			}
+			txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+			if err != nil {
+				return err
+			}
+			boxInfo, err := boxutils.GetBoxByID(cdc, cliCtx, boxID)
+			if err != nil {
+				return err
+			}
+			switch boxInfo.GetBoxType() {
+			case types.Vesting:
+				if !account.GetAddress().Equals(boxInfo.GetVesting().Beneficiary) {
+					return errors.Errorf(errors.ErrBeneficiaryMismatch(boxID))
+				}
+			case types.Deposit:
+				if boxInfo.GetBoxStatus() != types.DepositBoxMatured {
+					return errors.Errorf(errors.ErrNotAllowedOperation(boxInfo.GetBoxStatus()))
+				}
+			default:
+				return errors.Errorf(errors.ErrNotSupportOperation())
+			}
+
+			msg := msgs.NewMsgBoxClaim(boxID, account.GetAddress())
+			validateErr := msg.ValidateBasic()
+			if validateErr != nil {
+				return errors.Errorf(validateErr)
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return cmd
+}
+
+// GetCmdVestingBoxRevoke implements revoke a vesting box transaction command.
+func GetCmdVestingBoxRevoke(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revoke [box-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Revoke a vesting box",
+		Long:    "Owner revokes a revocable vesting box, the unvested amount is returned to the owner and the vested amount stays claimable by the beneficiary",
+		Example: "$ hashgardcli box revoke boxad3jlxpt2ps --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boxID := args[0]
+			if err := boxutils.CheckBoxId(boxID); err != nil {
+				return errors.Errorf(err)
+			}
+			txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+			if err != nil {
+				return err
+			}
+			boxInfo, err := boxutils.BoxOwnerCheck(cdc, cliCtx, account, boxID)
+			if err != nil {
+				return err
+			}
+			if boxInfo.GetBoxType() != types.Vesting || !boxInfo.GetVesting().Revocable {
+				return errors.Errorf(errors.ErrNotSupportOperation())
+			}
+
+			msg := msgs.NewMsgBoxRevoke(boxID, account.GetAddress())
+			validateErr := msg.ValidateBasic()
+			if validateErr != nil {
+				return errors.Errorf(validateErr)
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+	return cmd
+}
@@ -31,6 +31,10 @@ func (mc ModuleClient) GetBoxCmd() *cobra.Command {
 			boxCli.GetCmdQueryBox(mc.cdc),
 			boxCli.GetCmdSearchBoxs(mc.cdc),
 			boxCli.GetCmdQueryDepositBoxDeposit(mc.cdc),
+			boxCli.GetCmdQueryClaimable(mc.cdc),
+			boxCli.GetCmdQueryUnclaimed(mc.cdc),
+			boxCli.GetCmdQueryFailed(mc.cdc),
+			boxCli.GetCmdQueryEscrow(mc.cdc),
 		)...)
 	boxCmd.AddCommand(client.LineBreak)
 
@@ -41,12 +45,21 @@ func (mc ModuleClient) GetBoxCmd() *cobra.Command {
 		boxCli.GetCmdLockBoxCreate(mc.cdc),
 		cmdDepositBox,
 		boxCli.GetCmdFutureBoxCreate(mc.cdc),
+		boxCli.GetCmdVestingBoxCreate(mc.cdc),
+		boxCli.GetCmdVestingBoxClaim(mc.cdc),
+		boxCli.GetCmdVestingBoxRevoke(mc.cdc),
+		boxCli.GetCmdEscrowBoxCreate(mc.cdc),
+		boxCli.GetCmdEscrowBoxRelease(mc.cdc),
+		boxCli.GetCmdEscrowBoxRefund(mc.cdc),
+		boxCli.GetCmdSaleBoxCreate(mc.cdc),
 		boxCli.GetCmdDepositBoxInterestInjection(mc.cdc),
 		boxCli.GetCmdDepositBoxInterestFetch(mc.cdc),
 		boxCli.GetCmdDepositToBox(mc.cdc),
 		boxCli.GetCmdFetchDepositFromBox(mc.cdc),
+		boxCli.GetCmdDepositBoxWithdraw(mc.cdc),
 		boxCli.GetCmdBoxDescription(mc.cdc),
 		boxCli.GetCmdBoxDisableFeature(mc.cdc),
+		boxCli.GetCmdBoxRetry(mc.cdc),
 	)
 
 	for _, cmd := range txCmd {
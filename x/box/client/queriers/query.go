@@ -22,12 +22,24 @@ func GetQueryBoxsPath() string {
 func GetQueryDepositListPath() string {
 	return fmt.Sprintf("%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryDepositList)
 }
+func GetQueryUnclaimedPath() string {
+	return fmt.Sprintf("%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryUnclaimed)
+}
+func GetQueryFailedPath() string {
+	return fmt.Sprintf("%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryFailed)
+}
 func QueryBoxByName(boxType string, name string, cliCtx context.CLIContext) ([]byte, error) {
 	return cliCtx.QueryWithData(GetQueryBoxSearchPath(boxType, name), nil)
 }
 func GetQueryDepositAmountPath(boxID string, accAddress sdk.AccAddress) string {
 	return fmt.Sprintf("%s/%s/%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryDepositAmount, boxID, accAddress.String())
 }
+func GetQueryClaimablePath(boxID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryClaimable, boxID)
+}
+func GetQueryEscrowPath(accAddress sdk.AccAddress) string {
+	return fmt.Sprintf("%s/%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryEscrow, accAddress.String())
+}
 
 func QueryBoxByID(boxID string, cliCtx context.CLIContext) ([]byte, error) {
 	return cliCtx.QueryWithData(GetQueryBoxPath(boxID), nil)
@@ -35,6 +47,12 @@ func QueryBoxByID(boxID string, cliCtx context.CLIContext) ([]byte, error) {
 func QueryDepositAmountFromDepositBox(boxID string, accAddress sdk.AccAddress, cliCtx context.CLIContext) ([]byte, error) {
 	return cliCtx.QueryWithData(GetQueryDepositAmountPath(boxID, accAddress), nil)
 }
+func QueryClaimable(boxID string, cliCtx context.CLIContext) ([]byte, error) {
+	return cliCtx.QueryWithData(GetQueryClaimablePath(boxID), nil)
+}
+func QueryEscrow(accAddress sdk.AccAddress, cliCtx context.CLIContext) ([]byte, error) {
+	return cliCtx.QueryWithData(GetQueryEscrowPath(accAddress), nil)
+}
 func QueryBoxsList(params params.BoxQueryParams, cdc *codec.Codec, cliCtx context.CLIContext) ([]byte, error) {
 	bz, err := cdc.MarshalJSON(params)
 	if err != nil {
@@ -49,3 +67,13 @@ func QueryDepositList(params params.BoxQueryDepositListParams, cdc *codec.Codec,
 	}
 	return cliCtx.QueryWithData(GetQueryDepositListPath(), bz)
 }
+func QueryUnclaimed(params params.BoxQueryDepositListParams, cdc *codec.Codec, cliCtx context.CLIContext) ([]byte, error) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	return cliCtx.QueryWithData(GetQueryUnclaimedPath(), bz)
+}
+func QueryFailed(cliCtx context.CLIContext) ([]byte, error) {
+	return cliCtx.QueryWithData(GetQueryFailedPath(), nil)
+}
@@ -0,0 +1,7 @@
+package rest
+
+const (
+	restAddress    = "address"
+	restStartBoxId = "start_box_id"
+	restLimit      = "limit"
+)
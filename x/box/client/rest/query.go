@@ -0,0 +1,228 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/params"
+	"github.com/hashgard/hashgard/x/box/types"
+
+	"github.com/hashgard/hashgard/x/box/client/queriers"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(fmt.Sprintf("/%s/%s/{%s}", types.QuerierRoute, types.QueryBox, BoxID), queryBoxHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/%s/{%s}/{%s}", types.QuerierRoute, types.QuerySearch, BoxType, Name), queryBoxSearchHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/%s/{%s}", types.QuerierRoute, types.QueryList, BoxType), queryBoxsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/%s/{%s}", types.QuerierRoute, types.QueryDepositList, BoxID), queryDepositListHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/%s/{%s}/{%s}", types.QuerierRoute, types.QueryDepositAmount, BoxID, AccAddress), queryDepositAmountHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/%s/{%s}", types.QuerierRoute, types.QueryClaimable, BoxID), queryClaimableHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/%s/{%s}", types.QuerierRoute, types.QueryUnclaimed, BoxID), queryUnclaimedHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/%s", types.QuerierRoute, types.QueryFailed), queryFailedHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/%s/{%s}", types.QuerierRoute, types.QueryEscrow, AccAddress), queryEscrowHandlerFn(cdc, cliCtx)).Methods("GET")
+}
+func queryBoxHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := queriers.QueryBoxByID(boxID, cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+func queryBoxSearchHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		boxType := vars[BoxType]
+		if _, ok := types.BoxType[boxType]; !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.ErrUnknownBoxType().Error())
+			return
+		}
+
+		res, err := queriers.QueryBoxByName(boxType, strings.ToLower(vars[Name]), cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+func queryBoxsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		boxType := vars[BoxType]
+		if _, ok := types.BoxType[boxType]; !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.ErrUnknownBoxType().Error())
+			return
+		}
+		boxQueryParams := params.BoxQueryParams{
+			StartBoxId: r.URL.Query().Get(restStartBoxId),
+			BoxType:    boxType,
+			Limit:      30,
+		}
+		strAddress := r.URL.Query().Get(restAddress)
+		if len(strAddress) > 0 {
+			address, err := sdk.AccAddressFromBech32(strAddress)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			boxQueryParams.Owner = address
+		}
+		strNumLimit := r.URL.Query().Get(restLimit)
+		if len(strNumLimit) > 0 {
+			limit, err := strconv.Atoi(strNumLimit)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			boxQueryParams.Limit = limit
+		}
+
+		res, err := queriers.QueryBoxsList(boxQueryParams, cdc, cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+func queryDepositListHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		boxQueryParams := params.BoxQueryDepositListParams{
+			BoxId: boxID,
+		}
+		strAddress := r.URL.Query().Get(restAddress)
+		if len(strAddress) > 0 {
+			address, err := sdk.AccAddressFromBech32(strAddress)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			boxQueryParams.Owner = address
+		}
+
+		res, err := queriers.QueryDepositList(boxQueryParams, cdc, cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+func queryUnclaimedHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		boxQueryParams := params.BoxQueryDepositListParams{
+			BoxId: boxID,
+		}
+		strAddress := r.URL.Query().Get(restAddress)
+		if len(strAddress) > 0 {
+			address, err := sdk.AccAddressFromBech32(strAddress)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			boxQueryParams.Owner = address
+		}
+
+		res, err := queriers.QueryUnclaimed(boxQueryParams, cdc, cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+func queryFailedHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := queriers.QueryFailed(cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+func queryDepositAmountHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		address, err := sdk.AccAddressFromBech32(vars[AccAddress])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := queriers.QueryDepositAmountFromDepositBox(boxID, address, cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+func queryClaimableHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := queriers.QueryClaimable(boxID, cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+func queryEscrowHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		address, err := sdk.AccAddressFromBech32(vars[AccAddress])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := queriers.QueryEscrow(address, cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gorilla/mux"
+)
+
+const (
+	BoxID      = "box-id"
+	BoxType    = "box-type"
+	Feature    = "feature"
+	AccAddress = "accAddress"
+	Name       = "name"
+	Amount     = "amount"
+)
+
+// RegisterRoutes register distribution REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	registerQueryRoutes(cliCtx, r, cdc)
+	registerTxRoutes(cliCtx, r, cdc)
+}
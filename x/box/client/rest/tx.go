@@ -0,0 +1,244 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	clientutils "github.com/hashgard/hashgard/x/box/client/utils"
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
+)
+
+type PostDescriptionReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Description string       `json:"description"`
+}
+type PostBoxBaseReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc("/box/create-lock", postLockBoxCreateHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/box/create-deposit", postDepositBoxCreateHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/box/create-future", postFutureBoxCreateHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/box/create-vesting", postVestingBoxCreateHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/claim/{%s}", BoxID), postClaimHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/revoke/{%s}", BoxID), postRevokeHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/box/create-escrow", postEscrowBoxCreateHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/release/{%s}", BoxID), postReleaseHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/refund/{%s}", BoxID), postRefundHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/box/create-sale", postSaleBoxCreateHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/interest-injection/{%s}/{%s}", BoxID, Amount), postInterestInjectionHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/interest-fetch/{%s}/{%s}", BoxID, Amount), postInterestFetchHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/deposit-to/{%s}/{%s}", BoxID, Amount), postDepositToHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/deposit-fetch/{%s}/{%s}", BoxID, Amount), postDepositFetchHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/withdraw/{%s}/{%s}", BoxID, Amount), postWithdrawHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/describe/{%s}", BoxID), postDescribeHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/disable-feature/{%s}/{%s}", BoxID, Feature), postDisableFeatureHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/box/retry/{%s}", BoxID), postRetryHandlerFn(cdc, cliCtx)).Methods("POST")
+}
+
+func postDepositToHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return depositHandlerFn(cdc, cliCtx, types.DepositTo)
+}
+func postDepositFetchHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return depositHandlerFn(cdc, cliCtx, types.Fetch)
+}
+func depositHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, operation string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req PostBoxBaseReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		amount, ok := sdk.NewIntFromString(vars[Amount])
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Amount not a valid int")
+			return
+		}
+
+		account, err := cliCtx.GetAccount(fromAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg, err := clientutils.GetDepositMsg(cdc, cliCtx, account, vars[BoxID], amount, operation, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postDisableFeatureHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req PostBoxBaseReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		feature := vars[Feature]
+
+		_, ok := types.Features[feature]
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.ErrUnknownFeatures().Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		account, err := cliCtx.GetAccount(fromAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		boxInfo, err := boxutils.BoxOwnerCheck(cdc, cliCtx, account, boxID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if feature == types.Trade && !types.IsTradable(boxInfo.GetBoxType()) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.ErrNotSupportOperation().Error())
+			return
+		}
+
+		msg := msgs.NewMsgBoxDisableFeature(boxID, fromAddress, feature)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+func postDescribeHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req PostDescriptionReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(req.Description) <= 0 || !json.Valid([]byte(req.Description)) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.ErrBoxDescriptionNotValid().Error())
+			return
+		}
+
+		msg := msgs.NewMsgBoxDescription(boxID, fromAddress, []byte(req.Description))
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		account, err := cliCtx.GetAccount(fromAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		_, err = boxutils.BoxOwnerCheck(cdc, cliCtx, account, boxID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+func postRetryHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req PostBoxBaseReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// the owner or the retry authority of the params may retry, checked by the keeper
+		if _, err = boxutils.GetBoxByID(cdc, cliCtx, boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := msgs.NewMsgBoxRetry(boxID, fromAddress)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,169 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	clientutils "github.com/hashgard/hashgard/x/box/client/utils"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/params"
+	"github.com/hashgard/hashgard/x/box/types"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
+)
+
+type PostDepositBoxReq struct {
+	BaseReq                 rest.BaseReq `json:"base_req"`
+	params.BoxDepositParams `json:"box"`
+}
+
+func postDepositBoxCreateHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PostDepositBoxReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, req.TotalAmount.Token.Denom)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		interestDecimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, req.Deposit.Interest.Token.Denom)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		box := req.BoxDepositParams
+		box.Sender = fromAddress
+		box.BoxType = types.Deposit
+		box.TotalAmount.Decimals = decimals
+		box.Deposit.Interest.Decimals = interestDecimals
+		box.Deposit.Share = sdk.ZeroInt()
+		box.Deposit.TotalDeposit = sdk.ZeroInt()
+		box.Deposit.InterestInjections = nil
+		box.Deposit.Penalty = sdk.ZeroInt()
+		box.Deposit.CouponPayout = nil
+		box.Deposit.Claimed = sdk.ZeroInt()
+		if box.Deposit.PenaltyRate.IsNil() {
+			box.Deposit.PenaltyRate = sdk.ZeroDec()
+		}
+		box.Deposit.PerCoupon = boxutils.CalcInterestRate(box.TotalAmount.Token.Amount, box.Deposit.Price,
+			box.Deposit.Interest.Token.Amount, box.Deposit.Interest.Decimals)
+
+		msg := msgs.NewMsgDepositBox(&box)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postInterestInjectionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return interestHandlerFn(cdc, cliCtx, types.Injection)
+}
+func postInterestFetchHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return interestHandlerFn(cdc, cliCtx, types.Fetch)
+}
+func interestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, operation string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req PostBoxBaseReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		amount, ok := sdk.NewIntFromString(vars[Amount])
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Amount not a valid int")
+			return
+		}
+
+		account, err := cliCtx.GetAccount(fromAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg, err := clientutils.GetInterestMsg(cdc, cliCtx, account, vars[BoxID], amount, operation, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postWithdrawHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req PostBoxBaseReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		amount, ok := sdk.NewIntFromString(vars[Amount])
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Amount not a valid int")
+			return
+		}
+
+		account, err := cliCtx.GetAccount(fromAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg, err := clientutils.GetWithdrawMsg(cdc, cliCtx, account, vars[BoxID], amount, false)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
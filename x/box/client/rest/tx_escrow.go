@@ -0,0 +1,127 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/params"
+	"github.com/hashgard/hashgard/x/box/types"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
+)
+
+type PostEscrowBoxReq struct {
+	BaseReq                rest.BaseReq `json:"base_req"`
+	params.BoxEscrowParams `json:"box"`
+}
+
+func postEscrowBoxCreateHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PostEscrowBoxReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, req.TotalAmount.Token.Denom)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		box := req.BoxEscrowParams
+		box.Sender = fromAddress
+		box.BoxType = types.Escrow
+		box.TotalAmount.Decimals = decimals
+
+		msg := msgs.NewMsgEscrowBox(&box)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postReleaseHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return escrowHandlerFn(cdc, cliCtx, types.Release)
+}
+func postRefundHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return escrowHandlerFn(cdc, cliCtx, types.Refund)
+}
+func escrowHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, operation string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PostBoxBaseReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		vars := mux.Vars(r)
+		boxID := vars[BoxID]
+		if err := boxutils.CheckBoxId(boxID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		boxInfo, err := boxutils.GetBoxByID(cdc, cliCtx, boxID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if boxInfo.GetBoxType() != types.Escrow {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errors.ErrNotSupportOperation().Error())
+			return
+		}
+
+		var msg sdk.Msg
+		switch operation {
+		case types.Release:
+			if !boxInfo.GetEscrow().CanRelease(fromAddress) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, errors.ErrEscrowPartyMismatch(boxID, operation).Error())
+				return
+			}
+			msg = msgs.NewMsgBoxRelease(boxID, fromAddress)
+		default:
+			if !boxInfo.GetEscrow().CanRefund(fromAddress) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, errors.ErrEscrowPartyMismatch(boxID, operation).Error())
+				return
+			}
+			msg = msgs.NewMsgBoxRefund(boxID, fromAddress)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
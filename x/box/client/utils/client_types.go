@@ -46,9 +46,48 @@ type FutureBoxInfo struct {
 	TradeDisabled bool            `json:"trade_disabled"`
 	Future        types.FutureBox `json:"future"`
 }
+type VestingBoxInfo struct {
+	BoxId         string           `json:"box_id"`
+	BoxStatus     string           `json:"box_status"`
+	Owner         sdk.AccAddress   `json:"owner"`
+	Name          string           `json:"name"`
+	BoxType       string           `json:"type"`
+	CreatedTime   int64            `json:"created_time"`
+	TotalAmount   types.BoxToken   `json:"total_amount"`
+	Description   string           `json:"description"`
+	TradeDisabled bool             `json:"trade_disabled"`
+	Vesting       types.VestingBox `json:"vesting"`
+}
+type EscrowBoxInfo struct {
+	BoxId         string          `json:"box_id"`
+	BoxStatus     string          `json:"box_status"`
+	Owner         sdk.AccAddress  `json:"owner"`
+	Name          string          `json:"name"`
+	BoxType       string          `json:"type"`
+	CreatedTime   int64           `json:"created_time"`
+	TotalAmount   types.BoxToken  `json:"total_amount"`
+	Description   string          `json:"description"`
+	TradeDisabled bool            `json:"trade_disabled"`
+	Escrow        types.EscrowBox `json:"escrow"`
+}
+type SaleBoxInfo struct {
+	BoxId         string         `json:"box_id"`
+	BoxStatus     string         `json:"box_status"`
+	Owner         sdk.AccAddress `json:"owner"`
+	Name          string         `json:"name"`
+	BoxType       string         `json:"type"`
+	CreatedTime   int64          `json:"created_time"`
+	TotalAmount   types.BoxToken `json:"total_amount"`
+	Description   string         `json:"description"`
+	TradeDisabled bool           `json:"trade_disabled"`
+	Sale          types.SaleBox  `json:"sale"`
+}
 type LockBoxInfos []LockBoxInfo
 type DepositBoxInfos []DepositBoxInfo
 type FutureBoxInfos []FutureBoxInfo
+type VestingBoxInfos []VestingBoxInfo
+type EscrowBoxInfos []EscrowBoxInfo
+type SaleBoxInfos []SaleBoxInfo
 
 //nolint
 func getString(BoxId string, BoxStatus string, Owner sdk.AccAddress, Name string, BoxType string, CreatedTime int64,
@@ -126,3 +165,65 @@ func (bi FutureBoxInfos) String() string {
 	}
 	return strings.TrimSpace(out)
 }
+
+//nolint
+func (bi VestingBoxInfo) String() string {
+	str := getString(bi.BoxId, bi.BoxStatus, bi.Owner, bi.Name, bi.BoxType,
+		bi.CreatedTime, bi.TotalAmount, bi.Description, bi.TradeDisabled)
+
+	return fmt.Sprintf(`%s
+%s`, str, bi.Vesting.String())
+}
+
+//nolint
+func (bi VestingBoxInfos) String() string {
+	out := fmt.Sprintf("%-17s|%-44s|%-16s|%-36s|%s\n",
+		"BoxID", "Beneficiary", "Name", "TotalAmount", "EndTime")
+	for _, box := range bi {
+		out += fmt.Sprintf("%-17s|%-44s|%-16s|%-36s|%s\n",
+			box.BoxId, box.Vesting.Beneficiary.String(), box.Name, box.TotalAmount.Token.String(), time.Unix(box.Vesting.EndTime, 0).String())
+	}
+	return strings.TrimSpace(out)
+}
+
+//nolint
+func (bi EscrowBoxInfo) String() string {
+	str := getString(bi.BoxId, bi.BoxStatus, bi.Owner, bi.Name, bi.BoxType,
+		bi.CreatedTime, bi.TotalAmount, bi.Description, bi.TradeDisabled)
+
+	return fmt.Sprintf(`%s
+%s`, str, bi.Escrow.String())
+}
+
+//nolint
+func (bi EscrowBoxInfos) String() string {
+	out := fmt.Sprintf("%-17s|%-10s|%-44s|%-44s|%-36s|%s\n",
+		"BoxID", "Status", "Beneficiary", "Arbiter", "TotalAmount", "ExpireTime")
+	for _, box := range bi {
+		out += fmt.Sprintf("%-17s|%-10s|%-44s|%-44s|%-36s|%s\n",
+			box.BoxId, box.BoxStatus, box.Escrow.Beneficiary.String(), box.Escrow.Arbiter.String(),
+			box.TotalAmount.Token.String(), time.Unix(box.Escrow.ExpireTime, 0).String())
+	}
+	return strings.TrimSpace(out)
+}
+
+//nolint
+func (bi SaleBoxInfo) String() string {
+	str := getString(bi.BoxId, bi.BoxStatus, bi.Owner, bi.Name, bi.BoxType,
+		bi.CreatedTime, bi.TotalAmount, bi.Description, bi.TradeDisabled)
+
+	return fmt.Sprintf(`%s
+%s`, str, bi.Sale.String())
+}
+
+//nolint
+func (bi SaleBoxInfos) String() string {
+	out := fmt.Sprintf("%-17s|%-10s|%-36s|%-36s|%-20s|%s\n",
+		"BoxID", "Status", "TotalAmount", "Price", "TotalDeposit", "EndTime")
+	for _, box := range bi {
+		out += fmt.Sprintf("%-17s|%-10s|%-36s|%-36s|%-20s|%s\n",
+			box.BoxId, box.BoxStatus, box.TotalAmount.Token.String(), box.Sale.Price.String(),
+			box.Sale.TotalDeposit.String(), time.Unix(box.Sale.EndTime, 0).String())
+	}
+	return strings.TrimSpace(out)
+}
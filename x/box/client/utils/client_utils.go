@@ -9,8 +9,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/hashgard/hashgard/x/box/client/queriers"
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	boxutils "github.com/hashgard/hashgard/x/box/utils"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
 )
 
 func GetCliContext(cdc *codec.Codec) (authtxb.TxBuilder, context.CLIContext, auth.Account, error) {
@@ -23,6 +29,166 @@ func GetCliContext(cdc *codec.Codec) (authtxb.TxBuilder, context.CLIContext, aut
 
 	return txBldr, cliCtx, account, err
 }
+func GetDepositMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth.Account,
+	boxID string, amount sdk.Int, operation string, cli bool) (sdk.Msg, error) {
+	if err := boxutils.CheckBoxId(boxID); err != nil {
+		return nil, errors.Errorf(err)
+	}
+	boxInfo, err := boxutils.GetBoxByID(cdc, cliCtx, boxID)
+	if err != nil {
+		return nil, err
+	}
+	if boxInfo.GetBoxStatus() != types.BoxDepositing {
+		return nil, errors.Errorf(errors.ErrNotAllowedOperation(boxInfo.GetBoxStatus()))
+	}
+	denom := boxInfo.GetTotalAmount().Token.Denom
+	if boxInfo.GetBoxType() == types.Sale {
+		denom = boxInfo.GetSale().Price.Denom
+	}
+	if cli {
+		decimals, err := boxutils.GetDenomDecimals(cdc, cliCtx, denom)
+		if err != nil {
+			return nil, err
+		}
+		amount = issueutils.MulDecimals(amount, decimals)
+	}
+
+	switch operation {
+	case types.DepositTo:
+		if err = checkAmountByDepositTo(amount, boxInfo); err != nil {
+			return nil, err
+		}
+	case types.Fetch:
+		res, err := queriers.QueryDepositAmountFromDepositBox(boxID, account.GetAddress(), cliCtx)
+		if err == nil {
+			var depositAmount sdk.Int
+			cdc.MustUnmarshalJSON(res, &depositAmount)
+			if depositAmount.LT(amount) {
+				return nil, errors.Errorf(errors.ErrNotEnoughAmount())
+			}
+		}
+	default:
+		return nil, errors.ErrNotSupportOperation()
+	}
+	msg := msgs.NewMsgBoxDeposit(boxID, account.GetAddress(), sdk.NewCoin(denom, amount), operation)
+
+	validateErr := msg.ValidateBasic()
+	if validateErr != nil {
+		return nil, errors.Errorf(validateErr)
+	}
+	return msg, nil
+}
+
+func GetWithdrawMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth.Account,
+	boxID string, amount sdk.Int, cli bool) (sdk.Msg, error) {
+	if err := boxutils.CheckBoxId(boxID); err != nil {
+		return nil, errors.Errorf(err)
+	}
+	boxInfo, err := boxutils.GetBoxByID(cdc, cliCtx, boxID)
+	if err != nil {
+		return nil, err
+	}
+	if boxInfo.GetBoxType() != types.Deposit || !boxInfo.GetDeposit().EarlyWithdraw {
+		return nil, errors.Errorf(errors.ErrNotSupportOperation())
+	}
+	if boxInfo.GetBoxStatus() != types.DepositBoxInterest {
+		return nil, errors.Errorf(errors.ErrNotAllowedOperation(boxInfo.GetBoxStatus()))
+	}
+	if cli {
+		amount = issueutils.MulDecimals(amount, boxInfo.GetTotalAmount().Decimals)
+	}
+	if !amount.Mod(boxInfo.GetDeposit().Price).IsZero() {
+		return nil, errors.Errorf(errors.ErrAmountNotValid(amount.String()))
+	}
+	msg := msgs.NewMsgBoxWithdraw(boxID, account.GetAddress(), sdk.NewCoin(boxInfo.GetTotalAmount().Token.Denom, amount))
+	validateErr := msg.ValidateBasic()
+	if validateErr != nil {
+		return nil, errors.Errorf(validateErr)
+	}
+	return msg, nil
+}
+
+func checkAmountByDepositTo(amount sdk.Int, boxInfo types.Box) error {
+	switch boxInfo.GetBoxType() {
+	case types.Deposit:
+		if !amount.Mod(boxInfo.GetDeposit().Price).IsZero() {
+			return errors.ErrAmountNotValid(amount.String())
+		}
+		if amount.Add(boxInfo.GetDeposit().TotalDeposit).GT(boxInfo.GetTotalAmount().Token.Amount) {
+			return errors.Errorf(errors.ErrNotEnoughAmount())
+		}
+	case types.Future:
+		total := sdk.ZeroInt()
+		if boxInfo.GetFuture().Deposits != nil {
+			for _, v := range boxInfo.GetFuture().Deposits {
+				total = total.Add(v.Amount)
+			}
+		}
+		if amount.Add(total).GT(boxInfo.GetTotalAmount().Token.Amount) {
+			return errors.Errorf(errors.ErrNotEnoughAmount())
+		}
+	case types.Sale:
+		if !amount.IsPositive() {
+			return errors.ErrAmountNotValid(amount.String())
+		}
+	default:
+		return errors.Errorf(errors.ErrNotSupportOperation())
+	}
+	return nil
+}
+
+func GetInterestMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth.Account,
+	boxID string, amount sdk.Int, operation string, cli bool) (sdk.Msg, error) {
+	if err := boxutils.CheckBoxId(boxID); err != nil {
+		return nil, errors.Errorf(err)
+	}
+	box, err := boxutils.GetBoxByID(cdc, cliCtx, boxID)
+	if err != nil {
+		return nil, err
+	}
+	if box.GetBoxType() != types.Deposit {
+		return nil, errors.Errorf(errors.ErrNotSupportOperation())
+	}
+	if box.GetBoxStatus() != types.BoxCreated {
+		return nil, errors.Errorf(errors.ErrNotSupportOperation())
+	}
+	if cli {
+		amount = issueutils.MulDecimals(amount, box.GetDeposit().Interest.Decimals)
+	}
+	if types.Fetch == operation {
+		flag := true
+		for i, v := range box.GetDeposit().InterestInjections {
+			if v.Address.Equals(account.GetAddress()) {
+				if box.GetDeposit().InterestInjections[i].Amount.GTE(amount) {
+					flag = false
+					break
+				}
+			}
+		}
+		if flag {
+			return nil, errors.ErrNotEnoughAmount()
+		}
+	} else {
+		if box.GetDeposit().InterestInjections != nil {
+			totalInterest := sdk.ZeroInt()
+			for _, v := range box.GetDeposit().InterestInjections {
+				if v.Address.Equals(account.GetAddress()) {
+					totalInterest = totalInterest.Add(v.Amount)
+				}
+			}
+			if totalInterest.Add(amount).GT(box.GetDeposit().Interest.Token.Amount) {
+				return nil, errors.Errorf(errors.ErrInterestInjectionNotValid(sdk.NewCoin(box.GetDeposit().Interest.Token.Denom, amount)))
+			}
+		}
+	}
+	msg := msgs.NewMsgBoxInterest(boxID, account.GetAddress(), sdk.NewCoin(box.GetDeposit().Interest.Token.Denom, amount), operation)
+	validateErr := msg.ValidateBasic()
+	if validateErr != nil {
+		return nil, errors.Errorf(validateErr)
+	}
+	return msg, nil
+}
+
 func GetBoxInfo(cdc *codec.Codec, cliCtx context.CLIContext, box types.BoxInfo) fmt.Stringer {
 	switch box.BoxType {
 	case types.Lock:
@@ -37,6 +203,18 @@ func GetBoxInfo(cdc *codec.Codec, cliCtx context.CLIContext, box types.BoxInfo)
 		StructCopy(&clientBox, &box)
 		//clientBox.TotalAmount.Token = boxutils.GetBoxCoinByDecimal(cdc, cliCtx, box.TotalAmount.Token)
 		return clientBox
+	case types.Vesting:
+		var clientBox VestingBoxInfo
+		StructCopy(&clientBox, &box)
+		return clientBox
+	case types.Escrow:
+		var clientBox EscrowBoxInfo
+		StructCopy(&clientBox, &box)
+		return clientBox
+	case types.Sale:
+		var clientBox SaleBoxInfo
+		StructCopy(&clientBox, &box)
+		return clientBox
 	default:
 		return box
 	}
@@ -95,6 +273,33 @@ func GetBoxList(cdc *codec.Codec, cliCtx context.CLIContext, boxs types.BoxInfos
 			boxInfos = append(boxInfos, clientBox)
 		}
 
+		return boxInfos
+	case types.Vesting:
+		var boxInfos = make(VestingBoxInfos, 0, len(boxs))
+		for _, box := range boxs {
+			var clientBox VestingBoxInfo
+			StructCopy(&clientBox, &box)
+			boxInfos = append(boxInfos, clientBox)
+		}
+
+		return boxInfos
+	case types.Escrow:
+		var boxInfos = make(EscrowBoxInfos, 0, len(boxs))
+		for _, box := range boxs {
+			var clientBox EscrowBoxInfo
+			StructCopy(&clientBox, &box)
+			boxInfos = append(boxInfos, clientBox)
+		}
+
+		return boxInfos
+	case types.Sale:
+		var boxInfos = make(SaleBoxInfos, 0, len(boxs))
+		for _, box := range boxs {
+			var clientBox SaleBoxInfo
+			StructCopy(&clientBox, &box)
+			boxInfos = append(boxInfos, clientBox)
+		}
+
 		return boxInfos
 	}
 	return boxs
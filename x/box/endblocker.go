@@ -2,8 +2,6 @@ package box
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/hashgard/hashgard/x/box/tags"
 
@@ -11,50 +9,55 @@ import (
 	"github.com/hashgard/hashgard/x/box/types"
 )
 
-// Called every block, process inflation, update validator set
+// Called every block, process the due boxes, a box that fails is moved to the failed box store
+// without affecting the others, boxes over the per block limit carry over to the next blocks
 func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
 	resTags := sdk.NewTags()
-	// fetch active proposals whose voting periods have ended (are passed the block time)
-	activeIterator := keeper.ActiveBoxQueueIterator(ctx, ctx.BlockHeader().Time.Unix())
-	defer activeIterator.Close()
-	for ; activeIterator.Valid(); activeIterator.Next() {
-		var boxID string
-		var seq int
-		keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(activeIterator.Value(), &boxID)
-		if strings.Contains(boxID, types.KeyDelimiterString) {
-			boxIDs := strings.Split(boxID, types.KeyDelimiterString)
-			boxID = boxIDs[0]
-			seq, _ = strconv.Atoi(boxIDs[1])
-		}
-		boxInfo := keeper.GetBox(ctx, boxID)
-		if boxInfo == nil {
-			panic(fmt.Sprintf("box %s does not exist", boxID))
-			//continue
+	// params stored before the limit existed decode it as zero
+	limit := keeper.GetBoxConfigParams(ctx).MaxBoxesPerBlock
+	if limit == 0 {
+		limit = types.BoxMaxProcessPerBlock
+	}
+	// fetch active boxes whose times have passed (are passed the block time)
+	items := keeper.GetDueBoxQueueItems(ctx, ctx.BlockHeader().Time.Unix(), limit)
+	for _, item := range items {
+		boxID := item.BoxId
+		cacheCtx, write := ctx.CacheContext()
+		boxInfo, seq, err := keeper.ProcessBoxQueueItem(cacheCtx, boxID)
+		if err != nil {
+			keeper.FailBoxQueueItem(ctx, item, err.Error())
+			logger.Error(fmt.Sprintf("box %s failed: %s", boxID, err.Error()))
+			resTags = resTags.AppendTag(tags.BoxID, boxID).
+				AppendTag(tags.BoxStatus, types.BoxFailed).
+				AppendTag(tags.Reason, err.Error())
+			continue
 		}
+		write()
 		switch boxInfo.BoxType {
 		case types.Lock:
-			if err := keeper.ProcessLockBoxByEndBlocker(ctx, boxInfo); err != nil {
-				panic(err)
-			}
 			logger.Debug(fmt.Sprintf("lockbox %s (%s) unlocked", boxID, boxInfo.Name))
 			resTags = resTags.AppendTag(tags.BoxID, boxID).AppendTag(tags.BoxType, boxInfo.GetBoxType()).AppendTag(tags.BoxStatus, types.LockBoxUnlocked)
 		case types.Deposit:
-			if err := keeper.ProcessDepositBoxByEndBlocker(ctx, boxInfo); err != nil {
-				panic(err)
-			}
 			logger.Debug(fmt.Sprintf("depositbox %s (%s) status:%s", boxID, boxInfo.Name, boxInfo.BoxStatus))
 			resTags = resTags.AppendTag(tags.BoxID, boxID).AppendTag(tags.BoxType, boxInfo.GetBoxType()).AppendTag(tags.BoxStatus, boxInfo.BoxStatus)
 		case types.Future:
-			if err := keeper.ProcessFutureBoxByEndBlocker(ctx, boxInfo, seq); err != nil {
-				panic(err)
-			}
-			logger.Debug(fmt.Sprintf("futurebox %s (%s) status:%s,distributed:%s", boxID, boxInfo.Name, boxInfo.BoxStatus,
+			logger.Debug(fmt.Sprintf("futurebox %s (%s) status:%s,distributed:%s", boxInfo.BoxId, boxInfo.Name, boxInfo.BoxStatus,
 				fmt.Sprintf("%d", seq)))
-			resTags = resTags.AppendTag(tags.BoxID, boxID).
+			resTags = resTags.AppendTag(tags.BoxID, boxInfo.BoxId).
 				AppendTag(tags.BoxStatus, boxInfo.BoxStatus).
 				AppendTag(tags.Seq, fmt.Sprintf("%d", seq))
+		case types.Escrow:
+			logger.Debug(fmt.Sprintf("escrowbox %s (%s) expired", boxID, boxInfo.Name))
+			resTags = resTags.AppendTag(tags.BoxID, boxID).AppendTag(tags.BoxType, boxInfo.GetBoxType()).AppendTag(tags.BoxStatus, boxInfo.BoxStatus)
+		case types.Sale:
+			logger.Debug(fmt.Sprintf("salebox %s (%s) status:%s", boxID, boxInfo.Name, boxInfo.BoxStatus))
+			resTags = resTags.AppendTag(tags.BoxID, boxID).AppendTag(tags.BoxType, boxInfo.GetBoxType()).AppendTag(tags.BoxStatus, boxInfo.BoxStatus)
 		}
 	}
+	for _, boxInfo := range keeper.ProcessDepositBoxClaimByEndBlocker(ctx) {
+		logger.Debug(fmt.Sprintf("depositbox %s (%s) claimed:%s", boxInfo.BoxId, boxInfo.Name, boxInfo.Deposit.Claimed))
+		resTags = resTags.AppendTag(tags.BoxID, boxInfo.BoxId).AppendTag(tags.BoxType, boxInfo.GetBoxType()).AppendTag(tags.BoxStatus, boxInfo.BoxStatus)
+	}
 	return resTags
 }
@@ -26,6 +26,11 @@ const (
 	CodeNotAllowedOperation       sdk.CodeType = 15
 	CodeNotSupportOperation       sdk.CodeType = 16
 	CodeUnknownFeature            sdk.CodeType = 17
+	CodeTradeDisabled             sdk.CodeType = 18
+	CodeBeneficiaryMismatch       sdk.CodeType = 19
+	CodeEscrowPartyMismatch       sdk.CodeType = 20
+	CodeNoFailedBox               sdk.CodeType = 21
+	CodeDenomNotSupported         sdk.CodeType = 22
 )
 
 //convert sdk.Error to error
@@ -40,6 +45,9 @@ func ErrOwnerMismatch(boxID string) sdk.Error {
 func ErrDecimalsNotValid(decimals uint) sdk.Error {
 	return sdk.NewError(types.DefaultCodespace, CodeDecimalsNotValid, "%d is not a valid decimals", decimals)
 }
+func ErrDenomNotSupported(denom string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeDenomNotSupported, "%s is not a supported denom", denom)
+}
 func ErrTimelineNotValid(time []int64) sdk.Error {
 	return sdk.NewError(types.DefaultCodespace, CodeTimelineNotValid, "%d is not a valid time line", time)
 }
@@ -91,3 +99,15 @@ func ErrNotAllowedOperation(status string) sdk.Error {
 func ErrUnknownFeatures() sdk.Error {
 	return sdk.NewError(types.DefaultCodespace, CodeUnknownFeature, fmt.Sprintf("Unknown feature"))
 }
+func ErrCanNotTrade(boxID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeTradeDisabled, fmt.Sprintf("Trade of box %s is disabled", boxID))
+}
+func ErrBeneficiaryMismatch(boxID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeBeneficiaryMismatch, fmt.Sprintf("Beneficiary mismatch with box %s", boxID))
+}
+func ErrEscrowPartyMismatch(boxID string, operation string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeEscrowPartyMismatch, fmt.Sprintf("Sender is not allowed to %s escrow box %s", operation, boxID))
+}
+func ErrNoFailedBox(boxID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeNoFailedBox, fmt.Sprintf("Box %s has no failed entry to retry", boxID))
+}
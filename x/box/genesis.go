@@ -2,29 +2,51 @@ package box
 
 import (
 	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashgard/hashgard/x/box/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/hashgard/hashgard/x/box/keeper"
+	"github.com/hashgard/hashgard/x/box/params"
 )
 
 // GenesisState - all box state that must be provided at genesis
 type GenesisState struct {
-	StartingLockBoxId    uint64 `json:"starting_lock_box_id"`
-	StartingDepositBoxId uint64 `json:"starting_deposit_box_id"`
-	StartingFutureBoxId  uint64 `json:"starting_future_box_id"`
+	StartingLockBoxId    uint64                 `json:"starting_lock_box_id"`
+	StartingDepositBoxId uint64                 `json:"starting_deposit_box_id"`
+	StartingFutureBoxId  uint64                 `json:"starting_future_box_id"`
+	StartingVestingBoxId uint64                 `json:"starting_vesting_box_id"`
+	StartingEscrowBoxId  uint64                 `json:"starting_escrow_box_id"`
+	StartingSaleBoxId    uint64                 `json:"starting_sale_box_id"`
+	Params               params.BoxConfigParams `json:"params"`
+	Boxes                []BoxInfo              `json:"boxes"`
+	Deposits             []AddressBoxDeposit    `json:"deposits"`
+	ActiveQueue          []BoxQueueItem         `json:"active_queue"`
+	FailedBoxes          []BoxFailure           `json:"failed_boxes"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(startingLockBoxId uint64, startingDepositBoxId uint64, startingFutureBoxId uint64) GenesisState {
-	return GenesisState{startingLockBoxId, startingDepositBoxId, startingFutureBoxId}
+func NewGenesisState(startingLockBoxId uint64, startingDepositBoxId uint64, startingFutureBoxId uint64,
+	startingVestingBoxId uint64, startingEscrowBoxId uint64, startingSaleBoxId uint64, boxParams params.BoxConfigParams) GenesisState {
+	return GenesisState{
+		StartingLockBoxId:    startingLockBoxId,
+		StartingDepositBoxId: startingDepositBoxId,
+		StartingFutureBoxId:  startingFutureBoxId,
+		StartingVestingBoxId: startingVestingBoxId,
+		StartingEscrowBoxId:  startingEscrowBoxId,
+		StartingSaleBoxId:    startingSaleBoxId,
+		Params:               boxParams,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(types.BoxMinId, types.BoxMinId, types.BoxMinId)
+	return NewGenesisState(types.BoxMinId, types.BoxMinId, types.BoxMinId, types.BoxMinId, types.BoxMinId, types.BoxMinId,
+		params.BoxConfigParams{MaxBoxesPerBlock: types.BoxMaxProcessPerBlock})
 }
 
 // Returns if a GenesisState is empty or has data in it
@@ -52,6 +74,47 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) {
 	if err := keeper.SetInitialBoxStartingBoxId(ctx, types.Future, data.StartingFutureBoxId); err != nil {
 		panic(err)
 	}
+	if err := keeper.SetInitialBoxStartingBoxId(ctx, types.Vesting, data.StartingVestingBoxId); err != nil {
+		panic(err)
+	}
+	if err := keeper.SetInitialBoxStartingBoxId(ctx, types.Escrow, data.StartingEscrowBoxId); err != nil {
+		panic(err)
+	}
+	if err := keeper.SetInitialBoxStartingBoxId(ctx, types.Sale, data.StartingSaleBoxId); err != nil {
+		panic(err)
+	}
+	keeper.SetBoxConfigParams(ctx, data.Params)
+
+	for i := range data.Boxes {
+		box := data.Boxes[i]
+		keeper.SetBox(ctx, &box)
+		keeper.SetDepositedCoinsAddress(ctx, box.BoxId)
+
+		boxIDs := keeper.GetBoxIdsByAddress(ctx, box.BoxType, box.Owner)
+		boxIDs = append(boxIDs, box.BoxId)
+		keeper.SetAddress(ctx, box.BoxType, box.Owner, boxIDs)
+
+		boxIDs = keeper.GetBoxIdsByName(ctx, box.BoxType, box.Name)
+		boxIDs = append(boxIDs, box.BoxId)
+		keeper.SetName(ctx, box.BoxType, box.Name, boxIDs)
+
+		if box.BoxType == types.Deposit && box.BoxStatus == types.DepositBoxMatured && box.Deposit.AutoClaim {
+			keeper.SetClaimingBox(ctx, box.BoxId)
+		}
+	}
+
+	for _, deposit := range data.Deposits {
+		boxDeposit := types.BoxDeposit{Amount: deposit.Amount, Interest: deposit.Interest}
+		keeper.SetAddressDeposit(ctx, deposit.BoxId, deposit.Address, &boxDeposit)
+	}
+
+	for _, item := range data.ActiveQueue {
+		keeper.InsertActiveBoxQueue(ctx, item.EndTime, item.BoxId)
+	}
+
+	for _, failure := range data.FailedBoxes {
+		keeper.SetFailedBox(ctx, failure)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -71,10 +134,236 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	genesisState.StartingVestingBoxId, err = keeper.PeekCurrentBoxID(ctx, types.Vesting)
+	if err != nil {
+		panic(err)
+	}
+	genesisState.StartingEscrowBoxId, err = keeper.PeekCurrentBoxID(ctx, types.Escrow)
+	if err != nil {
+		panic(err)
+	}
+	genesisState.StartingSaleBoxId, err = keeper.PeekCurrentBoxID(ctx, types.Sale)
+	if err != nil {
+		panic(err)
+	}
+	genesisState.Boxes = keeper.GetAllBoxes(ctx)
+	genesisState.Deposits = keeper.GetAllDeposits(ctx)
+	genesisState.Params = keeper.GetBoxConfigParams(ctx)
+	genesisState.ActiveQueue = keeper.GetAllActiveBoxQueueItems(ctx)
+	genesisState.FailedBoxes = keeper.GetAllFailedBoxes(ctx)
 
 	return genesisState
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	startingBoxIDs := map[string]uint64{
+		types.Lock:    data.StartingLockBoxId,
+		types.Deposit: data.StartingDepositBoxId,
+		types.Future:  data.StartingFutureBoxId,
+		types.Vesting: data.StartingVestingBoxId,
+		types.Escrow:  data.StartingEscrowBoxId,
+		types.Sale:    data.StartingSaleBoxId,
+	}
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	boxes := make(map[string]BoxInfo, len(data.Boxes))
+	for _, box := range data.Boxes {
+		boxID := box.BoxId
+		if _, ok := boxes[boxID]; ok {
+			return fmt.Errorf("duplicate box %s found in genesis state", boxID)
+		}
+		boxes[boxID] = box
+
+		typeValue, err := types.GetBoxTypeValue(box.BoxType)
+		if err != nil {
+			return fmt.Errorf("box %s: %s", boxID, err)
+		}
+		prefix := types.IDPreStr + typeValue
+		if !strings.HasPrefix(boxID, prefix) {
+			return fmt.Errorf("invalid %s box id %s", box.BoxType, boxID)
+		}
+		seq, err := strconv.ParseUint(strings.TrimPrefix(boxID, prefix), 36, 64)
+		if err != nil {
+			return fmt.Errorf("invalid box id %s: %s", boxID, err)
+		}
+		if seq < types.BoxMinId || seq > types.BoxMaxId {
+			return fmt.Errorf("box id %s out of range", boxID)
+		}
+		if seq >= startingBoxIDs[box.BoxType] {
+			return fmt.Errorf("box id %s must be less than starting %s box id %d", boxID, box.BoxType, startingBoxIDs[box.BoxType])
+		}
+		if box.Owner.Empty() {
+			return fmt.Errorf("box %s has no owner", boxID)
+		}
+		if box.BoxType == types.Vesting {
+			if box.Vesting.Beneficiary.Empty() {
+				return fmt.Errorf("vesting box %s has no beneficiary", boxID)
+			}
+			if box.Vesting.Claimed.IsNil() || box.Vesting.Claimed.IsNegative() ||
+				box.Vesting.Claimed.GT(box.Vesting.GetFinalAmount(box.TotalAmount.Token.Amount)) {
+				return fmt.Errorf("vesting box %s has an invalid claimed amount", boxID)
+			}
+		}
+		if box.BoxType == types.Deposit && box.BoxStatus == types.DepositBoxMatured &&
+			(box.Deposit.Claimed.IsNil() || box.Deposit.Claimed.IsNegative() || box.Deposit.Claimed.GTE(box.Deposit.Share)) {
+			return fmt.Errorf("deposit box %s has an invalid claimed share", boxID)
+		}
+		if box.BoxType == types.Escrow && (box.Escrow.Beneficiary.Empty() || box.Escrow.Arbiter.Empty()) {
+			return fmt.Errorf("escrow box %s has no beneficiary or arbiter", boxID)
+		}
+		if box.BoxType == types.Sale && (box.Sale.Price.Amount.IsNil() || !box.Sale.Price.IsPositive() ||
+			box.Sale.TotalDeposit.IsNil() || box.Sale.TotalDeposit.IsNegative()) {
+			return fmt.Errorf("sale box %s has an invalid price or total deposit", boxID)
+		}
+	}
+
+	for _, deposit := range data.Deposits {
+		if _, ok := boxes[deposit.BoxId]; !ok {
+			return fmt.Errorf("deposit for unknown box %s", deposit.BoxId)
+		}
+		if deposit.Amount.IsNegative() || deposit.Interest.IsNegative() {
+			return fmt.Errorf("negative deposit of %s in box %s", deposit.Address, deposit.BoxId)
+		}
+	}
+
+	for _, item := range data.ActiveQueue {
+		keys := strings.Split(item.BoxId, types.KeyDelimiterString)
+		box, ok := boxes[keys[0]]
+		if !ok {
+			return fmt.Errorf("queue entry for unknown box %s", item.BoxId)
+		}
+		if len(keys) == 1 {
+			continue
+		}
+		seq, err := strconv.Atoi(keys[1])
+		if err != nil || box.BoxType != types.Future || len(keys) > 2 || seq < 0 || seq > len(box.Future.TimeLine) {
+			return fmt.Errorf("invalid queue entry %s", item.BoxId)
+		}
+	}
+
+	failed := make(map[string]bool, len(data.FailedBoxes))
+	for _, failure := range data.FailedBoxes {
+		if len(failure.BoxId) == 0 || failed[failure.BoxId] {
+			return fmt.Errorf("invalid failed box entry %s", failure.BoxId)
+		}
+		failed[failure.BoxId] = true
+	}
+
+	return nil
+}
+
+// ValidateGenesisDepositedCoins checks that the account holding the deposited
+// coins of every box has at least the coins recorded by the box and its deposits,
+// anybody can send coins to the account so it may hold more
+func ValidateGenesisDepositedCoins(data GenesisState, balances map[string]sdk.Coins) error {
+	deposits := depositsByBox(data.Deposits)
+	for _, box := range data.Boxes {
+		expected, err := depositedCoins(box, deposits[box.BoxId])
+		if err != nil {
+			return err
+		}
+		balance := balances[keeper.GetDepositedCoinsAddress(box.BoxId).String()]
+		for _, coin := range expected {
+			if balance.AmountOf(coin.Denom).LT(coin.Amount) {
+				return fmt.Errorf("box %s has deposited %s but its account holds %s", box.BoxId, expected, balance)
+			}
+		}
+	}
+	return nil
+}
+
+// depositsByBox groups deposits by the box they belong to
+func depositsByBox(deposits []AddressBoxDeposit) map[string][]AddressBoxDeposit {
+	res := make(map[string][]AddressBoxDeposit)
+	for _, deposit := range deposits {
+		res[deposit.BoxId] = append(res[deposit.BoxId], deposit)
+	}
+	return res
+}
+
+// depositedCoins returns the coins a box must hold for its current status
+func depositedCoins(box BoxInfo, deposits []AddressBoxDeposit) (sdk.Coins, error) {
+	coins := sdk.Coins{}
+	switch box.BoxType {
+	case types.Lock:
+		if box.BoxStatus == types.LockBoxLocked {
+			coins = coins.Add(sdk.NewCoins(box.TotalAmount.Token))
+		}
+	case types.Deposit:
+		if box.BoxStatus == types.BoxFinished {
+			break
+		}
+		for _, v := range box.Deposit.InterestInjections {
+			coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.Deposit.Interest.Token.Denom, v.Amount)))
+		}
+		if box.BoxStatus == types.BoxCreated {
+			break
+		}
+		if box.BoxStatus == types.DepositBoxMatured {
+			coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, box.Deposit.Share.Mul(box.Deposit.Price))))
+		} else {
+			for _, v := range deposits {
+				coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, v.Amount)))
+			}
+		}
+		if !box.Deposit.Penalty.IsNil() {
+			coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, box.Deposit.Penalty)))
+		}
+		if box.BoxStatus == types.DepositBoxMatured && !box.Deposit.Claimed.IsNil() {
+			var negative bool
+			if coins, negative = coins.SafeSub(box.Deposit.GetPayout(box.Deposit.Claimed)); negative {
+				return nil, fmt.Errorf("box %s has paid more than its deposited coins", box.BoxId)
+			}
+		}
+	case types.Future:
+		switch box.BoxStatus {
+		case types.BoxDepositing:
+			for _, v := range box.Future.Deposits {
+				coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, v.Amount)))
+			}
+		case types.BoxActived:
+			amount := box.TotalAmount.Token.Amount
+			for _, items := range box.Future.Receivers {
+				for seq := 1; seq <= len(box.Future.Distributed) && seq < len(items); seq++ {
+					distributed, ok := sdk.NewIntFromString(items[seq])
+					if !ok {
+						return nil, fmt.Errorf("box %s has an invalid receiver amount %s", box.BoxId, items[seq])
+					}
+					amount = amount.Sub(distributed)
+				}
+			}
+			if amount.IsNegative() {
+				return nil, fmt.Errorf("box %s has distributed more than its total amount", box.BoxId)
+			}
+			coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, amount)))
+		}
+	case types.Vesting:
+		amount := box.Vesting.GetFinalAmount(box.TotalAmount.Token.Amount).Sub(box.Vesting.Claimed)
+		if amount.IsNegative() {
+			return nil, fmt.Errorf("box %s has claimed more than its vested amount", box.BoxId)
+		}
+		coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, amount)))
+	case types.Escrow:
+		if box.BoxStatus == types.BoxActived {
+			coins = coins.Add(sdk.NewCoins(box.TotalAmount.Token))
+		}
+	case types.Sale:
+		if box.BoxStatus != types.BoxCreated && box.BoxStatus != types.BoxDepositing && box.BoxStatus != types.SaleBoxSettling {
+			break
+		}
+		unsold := box.TotalAmount.Token
+		if box.BoxStatus == types.SaleBoxSettling {
+			unsold = unsold.Sub(sdk.NewCoin(unsold.Denom, box.Sale.Sold))
+			coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.Sale.Price.Denom, box.Sale.Raised)))
+		}
+		coins = coins.Add(sdk.NewCoins(unsold))
+		for _, v := range deposits {
+			coins = coins.Add(sdk.NewCoins(sdk.NewCoin(box.Sale.Price.Denom, v.Amount)))
+		}
+	}
+	return coins, nil
+}
@@ -21,6 +21,12 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 			return handlers.HandleMsgDepositBox(ctx, keeper, msg)
 		case msgs.MsgFutureBox:
 			return handlers.HandleMsgFutureBox(ctx, keeper, msg)
+		case msgs.MsgVestingBox:
+			return handlers.HandleMsgVestingBox(ctx, keeper, msg)
+		case msgs.MsgEscrowBox:
+			return handlers.HandleMsgEscrowBox(ctx, keeper, msg)
+		case msgs.MsgSaleBox:
+			return handlers.HandleMsgSaleBox(ctx, keeper, msg)
 		case msgs.MsgBoxInterest:
 			return handlers.HandleMsgBoxInterest(ctx, keeper, msg)
 		case msgs.MsgBoxDeposit:
@@ -29,6 +35,18 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 			return handlers.HandleMsgBoxDescription(ctx, keeper, msg)
 		case msgs.MsgBoxDisableFeature:
 			return handlers.HandleMsgBoxDisableFeature(ctx, keeper, msg)
+		case msgs.MsgBoxClaim:
+			return handlers.HandleMsgBoxClaim(ctx, keeper, msg)
+		case msgs.MsgBoxRevoke:
+			return handlers.HandleMsgBoxRevoke(ctx, keeper, msg)
+		case msgs.MsgBoxRelease:
+			return handlers.HandleMsgBoxRelease(ctx, keeper, msg)
+		case msgs.MsgBoxRefund:
+			return handlers.HandleMsgBoxRefund(ctx, keeper, msg)
+		case msgs.MsgBoxWithdraw:
+			return handlers.HandleMsgBoxWithdraw(ctx, keeper, msg)
+		case msgs.MsgBoxRetry:
+			return handlers.HandleMsgBoxRetry(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized gov msg type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -49,6 +49,48 @@ func HandleMsgFutureBox(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgFutur
 	}
 	return createBox(ctx, keeper, box)
 }
+
+//Handle MsgVestingBox
+func HandleMsgVestingBox(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgVestingBox) sdk.Result {
+	box := &types.BoxInfo{
+		Owner:         msg.Sender,
+		Name:          msg.Name,
+		BoxType:       msg.BoxType,
+		TotalAmount:   msg.TotalAmount,
+		Description:   msg.Description,
+		TradeDisabled: true,
+		Vesting:       msg.Vesting,
+	}
+	return createBox(ctx, keeper, box)
+}
+
+//Handle MsgEscrowBox
+func HandleMsgEscrowBox(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgEscrowBox) sdk.Result {
+	box := &types.BoxInfo{
+		Owner:         msg.Sender,
+		Name:          msg.Name,
+		BoxType:       msg.BoxType,
+		TotalAmount:   msg.TotalAmount,
+		Description:   msg.Description,
+		TradeDisabled: true,
+		Escrow:        msg.Escrow,
+	}
+	return createBox(ctx, keeper, box)
+}
+
+//Handle MsgSaleBox
+func HandleMsgSaleBox(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgSaleBox) sdk.Result {
+	box := &types.BoxInfo{
+		Owner:         msg.Sender,
+		Name:          msg.Name,
+		BoxType:       msg.BoxType,
+		TotalAmount:   msg.TotalAmount,
+		Description:   msg.Description,
+		TradeDisabled: true,
+		Sale:          msg.Sale,
+	}
+	return createBox(ctx, keeper, box)
+}
 func createBox(ctx sdk.Context, keeper keeper.Keeper, box *types.BoxInfo) sdk.Result {
 	err := keeper.CreateBox(ctx, box)
 	if err != nil {
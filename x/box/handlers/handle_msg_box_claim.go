@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box/tags"
+
+	"github.com/hashgard/hashgard/x/box/keeper"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/utils"
+)
+
+//Handle MsgBoxClaim
+func HandleMsgBoxClaim(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgBoxClaim) sdk.Result {
+	boxInfo, claim, err := keeper.ClaimBox(ctx, msg.Sender, msg.BoxId)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.BoxId),
+		Tags: utils.GetBoxTags(msg.BoxId, boxInfo.BoxType, msg.Sender).
+			AppendTag(tags.Amount, claim.String()).
+			AppendTag(tags.BoxStatus, boxInfo.BoxStatus),
+	}
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box/tags"
+
+	"github.com/hashgard/hashgard/x/box/keeper"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/utils"
+)
+
+//Handle MsgBoxRefund
+func HandleMsgBoxRefund(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgBoxRefund) sdk.Result {
+	boxInfo, err := keeper.RefundEscrowBox(ctx, msg.Sender, msg.BoxId)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.BoxId),
+		Tags: utils.GetBoxTags(msg.BoxId, boxInfo.BoxType, msg.Sender).
+			AppendTag(tags.BoxStatus, boxInfo.BoxStatus),
+	}
+}
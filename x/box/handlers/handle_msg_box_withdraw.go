@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box/tags"
+
+	"github.com/hashgard/hashgard/x/box/keeper"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/utils"
+)
+
+//Handle MsgBoxWithdraw
+func HandleMsgBoxWithdraw(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgBoxWithdraw) sdk.Result {
+	boxInfo, payout, err := keeper.WithdrawFromDepositBox(ctx, msg.Sender, msg.BoxId, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.BoxId),
+		Tags: utils.GetBoxTags(msg.BoxId, boxInfo.BoxType, msg.Sender).
+			AppendTag(tags.Amount, payout.String()).
+			AppendTag(tags.BoxStatus, boxInfo.BoxStatus),
+	}
+}
@@ -0,0 +1,41 @@
+package box
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/keeper"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// expected crisis keeper
+type CrisisKeeper interface {
+	RegisterRoute(moduleName, route string, invar sdk.Invariant)
+}
+
+// register box invariants
+func RegisterInvariants(c CrisisKeeper, keeper keeper.Keeper) {
+	c.RegisterRoute(types.ModuleName, "deposited-coins", DepositedCoinsInvariant(keeper))
+}
+
+// DepositedCoinsInvariant checks that the account of every box holds at least its recorded deposits and interest,
+// anybody can send coins to the account so it may hold more
+func DepositedCoinsInvariant(keeper keeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		deposits := depositsByBox(keeper.GetAllDeposits(ctx))
+		for _, box := range keeper.GetAllBoxes(ctx) {
+			expected, err := depositedCoins(box, deposits[box.BoxId])
+			if err != nil {
+				return err
+			}
+			balance := keeper.GetDepositedCoins(ctx, box.BoxId)
+			for _, coin := range expected {
+				if balance.AmountOf(coin.Denom).LT(coin.Amount) {
+					return fmt.Errorf("box %s has deposited %s but its account holds %s", box.BoxId, expected, balance)
+				}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+var _ bank.Keeper = TradeBankKeeper{}
+
+// TradeBankKeeper wraps a bank keeper and rejects any transfer of box coins
+// whose trade is disabled
+type TradeBankKeeper struct {
+	bank.Keeper
+	bk Keeper
+}
+
+func NewTradeBankKeeper(ck bank.Keeper, bk Keeper) TradeBankKeeper {
+	return TradeBankKeeper{
+		Keeper: ck,
+		bk:     bk,
+	}
+}
+
+//Send coins after checking the box trade
+func (keeper TradeBankKeeper) SendCoins(ctx sdk.Context,
+	fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if err := keeper.bk.CheckTrade(ctx, amt); err != nil {
+		return err
+	}
+	return keeper.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+//Multi send coins after checking the box trade of every input
+func (keeper TradeBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error {
+	for _, in := range inputs {
+		if err := keeper.bk.CheckTrade(ctx, in.Coins); err != nil {
+			return err
+		}
+	}
+	return keeper.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/types"
+	"github.com/hashgard/hashgard/x/box/utils"
+	issueerr "github.com/hashgard/hashgard/x/issue/errors"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Process box token denoms
+
+//Returns the decimals of a denom, issued coins take them from the issue, native units such as gard and agard
+//from the registered coin units, any other denom is counted in its base unit
+func (keeper Keeper) GetDenomDecimals(ctx sdk.Context, denom string) (uint, sdk.Error) {
+	if utils.IsBoxId(denom) {
+		return 0, errors.ErrDenomNotSupported(denom)
+	}
+	if issueutils.IsIssueId(denom) {
+		coinIssueInfo := keeper.GetIssueKeeper().GetIssue(ctx, denom)
+		if coinIssueInfo == nil {
+			return 0, issueerr.ErrUnknownIssue(denom)
+		}
+		return coinIssueInfo.GetDecimals(), nil
+	}
+	decimals, _ := utils.GetNativeDenomDecimals(denom)
+	return decimals, nil
+}
+
+//Check the decimals of a box token against its denom
+func (keeper Keeper) CheckBoxToken(ctx sdk.Context, token types.BoxToken) sdk.Error {
+	decimals, err := keeper.GetDenomDecimals(ctx, token.Token.Denom)
+	if err != nil {
+		return err
+	}
+	if token.Decimals != decimals {
+		return errors.ErrDecimalsNotValid(token.Decimals)
+	}
+	return nil
+}
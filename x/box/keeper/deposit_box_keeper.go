@@ -1,28 +1,31 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/hashgard/hashgard/x/box/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hashgard/hashgard/x/box/errors"
 	"github.com/hashgard/hashgard/x/box/types"
-	issueerr "github.com/hashgard/hashgard/x/issue/errors"
 )
 
 //Process deposit box
 
 func (keeper Keeper) ProcessDepositBoxCreate(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
-	coinIssueInfo := keeper.ik.GetIssue(ctx, box.Deposit.Interest.Token.Denom)
-	if coinIssueInfo == nil {
-		return issueerr.ErrUnknownIssue(box.Deposit.Interest.Token.Denom)
-	}
-	if box.Deposit.Interest.Decimals != coinIssueInfo.GetDecimals() {
-		return errors.ErrDecimalsNotValid(box.Deposit.Interest.Decimals)
+	if err := keeper.CheckBoxToken(ctx, box.Deposit.Interest); err != nil {
+		return err
 	}
 	box.BoxStatus = types.BoxCreated
 	box.Deposit.TotalDeposit = sdk.ZeroInt()
 	//box.Deposit.TotalInterestInjection = sdk.ZeroInt()
 	box.Deposit.Share = sdk.ZeroInt()
+	box.Deposit.Penalty = sdk.ZeroInt()
+	box.Deposit.CouponPayout = nil
+	box.Deposit.Claimed = sdk.ZeroInt()
+	if box.Deposit.PenaltyRate.IsNil() {
+		box.Deposit.PenaltyRate = sdk.ZeroDec()
+	}
 	keeper.InsertActiveBoxQueue(ctx, box.Deposit.StartTime, box.BoxId)
 	return nil
 }
@@ -73,7 +76,7 @@ func (keeper Keeper) injectionDepositBoxInterest(ctx sdk.Context, boxID string,
 	if err := keeper.SendDepositedCoin(ctx, sender, sdk.Coins{interest}, boxID); err != nil {
 		return nil, err
 	}
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return box, nil
 }
 func (keeper Keeper) fetchInterestFromDepositBox(ctx sdk.Context, boxID string, sender sdk.AccAddress, interest sdk.Coin) (*types.BoxInfo, sdk.Error) {
@@ -109,7 +112,7 @@ func (keeper Keeper) fetchInterestFromDepositBox(ctx sdk.Context, boxID string,
 	if err := keeper.FetchDepositedCoin(ctx, sender, sdk.Coins{interest}, boxID); err != nil {
 		return nil, err
 	}
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return box, nil
 }
 func (keeper Keeper) processDepositBoxDeposit(ctx sdk.Context, box *types.BoxInfo, sender sdk.AccAddress, deposit sdk.Coin, operation string) sdk.Error {
@@ -137,7 +140,7 @@ func (keeper Keeper) depositToDepositBox(ctx sdk.Context, box *types.BoxInfo, se
 	}
 	keeper.addAddressDeposit(ctx, box.BoxId, sender, types.NewBoxDeposit(deposit.Amount))
 	box.Deposit.Share = box.Deposit.Share.Add(deposit.Amount.Quo(box.Deposit.Price))
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return nil
 }
 func (keeper Keeper) fetchDepositFromDepositBox(ctx sdk.Context, box *types.BoxInfo, sender sdk.AccAddress, deposit sdk.Coin) sdk.Error {
@@ -155,10 +158,98 @@ func (keeper Keeper) fetchDepositFromDepositBox(ctx sdk.Context, box *types.BoxI
 	if boxDeposit.Amount.IsZero() {
 		keeper.removeAddressDeposit(ctx, box.BoxId, sender)
 	} else {
-		keeper.setAddressDeposit(ctx, box.BoxId, sender, boxDeposit)
+		keeper.SetAddressDeposit(ctx, box.BoxId, sender, boxDeposit)
 	}
 	box.Deposit.Share = box.Deposit.Share.Sub(deposit.Amount.Quo(box.Deposit.Price))
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
+	return nil
+}
+
+//Certificate holder withdraws principal from a deposit box before maturity, the interest of the withdrawn
+//coupons and the penalty go to the remaining holders, or back to the interest injectors if none remain
+func (keeper Keeper) WithdrawFromDepositBox(ctx sdk.Context, sender sdk.AccAddress, boxID string, withdraw sdk.Coin) (*types.BoxInfo, sdk.Coin, sdk.Error) {
+	box := keeper.GetBox(ctx, boxID)
+	if box == nil {
+		return nil, sdk.Coin{}, errors.ErrUnknownBox(boxID)
+	}
+	if box.BoxType != types.Deposit || !box.Deposit.EarlyWithdraw {
+		return nil, sdk.Coin{}, errors.ErrNotSupportOperation()
+	}
+	if box.BoxStatus != types.DepositBoxInterest {
+		return nil, sdk.Coin{}, errors.ErrNotAllowedOperation(box.BoxStatus)
+	}
+	amount := withdraw.Amount
+	if box.TotalAmount.Token.Denom != withdraw.Denom || !amount.IsPositive() || !amount.Mod(box.Deposit.Price).IsZero() {
+		return nil, sdk.Coin{}, errors.ErrAmountNotValid(withdraw.String())
+	}
+	share := amount.Quo(box.Deposit.Price)
+	if keeper.ck.GetCoins(ctx, sender).AmountOf(box.BoxId).LT(share) {
+		return nil, sdk.Coin{}, errors.ErrNotEnoughAmount()
+	}
+	if _, err := keeper.ck.SubtractCoins(ctx, sender, sdk.NewCoins(sdk.NewCoin(box.BoxId, share))); err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	penalty := box.Deposit.GetPenalty(amount)
+	payout := sdk.NewCoin(box.TotalAmount.Token.Denom, amount.Sub(penalty))
+	if err := keeper.FetchDepositedCoin(ctx, sender, sdk.NewCoins(payout), box.BoxId); err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	//the certificates may have been bought from the depositor, who then has no deposit left to reduce
+	boxDeposit := keeper.GetDepositByAddress(ctx, boxID, sender)
+	if boxDeposit.Amount.GT(amount) {
+		boxDeposit.Amount = boxDeposit.Amount.Sub(amount)
+		keeper.SetAddressDeposit(ctx, box.BoxId, sender, boxDeposit)
+	} else {
+		keeper.removeAddressDeposit(ctx, box.BoxId, sender)
+	}
+	box.Deposit.Share = box.Deposit.Share.Sub(share)
+	box.Deposit.TotalDeposit = box.Deposit.TotalDeposit.Sub(amount)
+	box.Deposit.Penalty = box.Deposit.Penalty.Add(penalty)
+
+	if box.Deposit.Share.IsZero() {
+		if err := keeper.closeWithdrawnDepositBox(ctx, box); err != nil {
+			return nil, sdk.Coin{}, err
+		}
+		return box, payout, nil
+	}
+	box.Deposit.PerCoupon = utils.CalcInterestRate(box.Deposit.TotalDeposit, box.Deposit.Price,
+		box.Deposit.Interest.Token.Amount, box.Deposit.Interest.Decimals)
+	keeper.SetBox(ctx, box)
+	return box, payout, nil
+}
+
+//Returns the interest and the penalty to the interest injectors when every depositor has withdrawn,
+//the penalty goes to the owner if nothing was injected
+func (keeper Keeper) closeWithdrawnDepositBox(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
+	if err := keeper.backBoxInterestInjections(ctx, box); err != nil {
+		return err
+	}
+	total := sdk.ZeroInt()
+	for _, v := range box.Deposit.InterestInjections {
+		total = total.Add(v.Amount)
+	}
+	if total.IsZero() {
+		if err := keeper.FetchDepositedCoin(ctx, box.Owner,
+			sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, box.Deposit.Penalty)), box.BoxId); err != nil {
+			return err
+		}
+	} else {
+		distributed := sdk.ZeroInt()
+		for i, v := range box.Deposit.InterestInjections {
+			amount := box.Deposit.Penalty.Sub(distributed)
+			if i < len(box.Deposit.InterestInjections)-1 {
+				amount = box.Deposit.Penalty.Mul(v.Amount).Quo(total)
+			}
+			if err := keeper.FetchDepositedCoin(ctx, v.Address,
+				sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, amount)), box.BoxId); err != nil {
+				return err
+			}
+			distributed = distributed.Add(amount)
+		}
+	}
+	keeper.RemoveFromActiveBoxQueue(ctx, box.Deposit.MaturityTime, box.BoxId)
+	box.BoxStatus = types.BoxClosed
+	keeper.RemoveBox(ctx, box)
 	return nil
 }
 func (keeper Keeper) ProcessDepositBoxByEndBlocker(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
@@ -213,7 +304,7 @@ func (keeper Keeper) backBoxUnUsedInterestInjections(ctx sdk.Context, box *types
 		}
 	}
 	box.Deposit.Interest.Token.Amount = box.Deposit.Interest.Token.Amount.Sub(unused)
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return nil
 }
 func (keeper Keeper) backBoxAllDeposit(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
@@ -239,7 +330,7 @@ func (keeper Keeper) processBoxCreatedByEndBlocker(ctx sdk.Context, box *types.B
 	if box.Deposit.Interest.Token.Amount.Equal(totalInterest) {
 		box.BoxStatus = types.BoxDepositing
 		keeper.InsertActiveBoxQueue(ctx, box.Deposit.EstablishTime, box.BoxId)
-		keeper.setBox(ctx, box)
+		keeper.SetBox(ctx, box)
 	} else {
 		box.BoxStatus = types.BoxClosed
 		if err := keeper.backBoxInterestInjections(ctx, box); err != nil {
@@ -289,7 +380,7 @@ func (keeper Keeper) processDepositBoxDepositToByEndBlocker(ctx sdk.Context, box
 			return err
 		}
 		keeper.InsertActiveBoxQueue(ctx, box.Deposit.MaturityTime, box.BoxId)
-		keeper.setBox(ctx, box)
+		keeper.SetBox(ctx, box)
 	}
 	return nil
 }
@@ -297,50 +388,171 @@ func (keeper Keeper) processDepositBoxInterestByEndBlocker(ctx sdk.Context, box
 	if box.BoxStatus != types.DepositBoxInterest {
 		return nil
 	}
-	store := ctx.KVStore(keeper.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyDeposit(box.BoxId))
-	defer iterator.Close()
-	totalInterest := sdk.ZeroInt()
-	var address sdk.AccAddress
-	var boxDeposit types.BoxDeposit
-	for ; iterator.Valid(); iterator.Next() {
-		bz := iterator.Value()
-		if len(bz) == 0 {
-			continue
+	keeper.RemoveFromActiveBoxQueue(ctx, box.Deposit.MaturityTime, box.BoxId)
+	principal := box.Deposit.Price
+	if !box.Deposit.Penalty.IsNil() && box.Deposit.Share.IsPositive() {
+		principal = principal.Add(box.Deposit.Penalty.Quo(box.Deposit.Share))
+	}
+	interest := utils.MulMaxPrecisionByDecimal(box.Deposit.PerCoupon, box.Deposit.Interest.Decimals)
+	box.Deposit.CouponPayout = sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, principal)).
+		Add(sdk.NewCoins(sdk.NewCoin(box.Deposit.Interest.Token.Denom, interest)))
+	box.Deposit.Claimed = sdk.ZeroInt()
+	box.BoxStatus = types.DepositBoxMatured
+	if box.Deposit.AutoClaim {
+		keeper.SetClaimingBox(ctx, box.BoxId)
+	}
+	keeper.SetBox(ctx, box)
+	return nil
+}
+
+//Certificate holder claims the principal and the interest of a matured deposit box, the certificate coins are burned
+func (keeper Keeper) ClaimDepositBox(ctx sdk.Context, sender sdk.AccAddress, boxID string) (*types.BoxInfo, sdk.Coins, sdk.Error) {
+	box := keeper.GetBox(ctx, boxID)
+	if box == nil {
+		return nil, nil, errors.ErrUnknownBox(boxID)
+	}
+	if box.BoxType != types.Deposit {
+		return nil, nil, errors.ErrNotSupportOperation()
+	}
+	if box.BoxStatus != types.DepositBoxMatured {
+		return nil, nil, errors.ErrNotAllowedOperation(box.BoxStatus)
+	}
+	payout, err := keeper.claimDepositBox(ctx, box, sender)
+	if err != nil {
+		return nil, nil, err
+	}
+	keeper.SetBox(ctx, box)
+	return box, payout, nil
+}
+
+//Pays all the certificate coins the address holds, which may have been bought from the depositors,
+//the last claim also takes the rounding remainder left in the box and finishes it
+func (keeper Keeper) claimDepositBox(ctx sdk.Context, box *types.BoxInfo, address sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	share := keeper.ck.GetCoins(ctx, address).AmountOf(box.BoxId)
+	if !share.IsPositive() {
+		return nil, errors.ErrNotEnoughAmount()
+	}
+	if _, err := keeper.ck.SubtractCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(box.BoxId, share))); err != nil {
+		return nil, err
+	}
+	payout := box.Deposit.GetPayout(share)
+	box.Deposit.Claimed = box.Deposit.Claimed.Add(share)
+	if box.Deposit.Claimed.GTE(box.Deposit.Share) {
+		payout = keeper.GetDepositedCoins(ctx, box.BoxId)
+		box.BoxStatus = types.BoxFinished
+		keeper.removeClaimingBox(ctx, box.BoxId)
+	}
+	if err := keeper.FetchDepositedCoin(ctx, address, payout, box.BoxId); err != nil {
+		return nil, err
+	}
+	keeper.removeAddressDeposit(ctx, box.BoxId, address)
+	return payout, nil
+}
+
+//Pays the depositors of matured deposit boxes with auto claim, at most BoxMaxClaimBatch claims per block,
+//auto claim is turned off for a box whose claim fails and its depositors claim by themselves.
+//Depositors who sold their certificates are skipped, the buyers claim by themselves
+func (keeper Keeper) ProcessDepositBoxClaimByEndBlocker(ctx sdk.Context) []*types.BoxInfo {
+	boxes := make([]*types.BoxInfo, 0)
+	limit := types.BoxMaxClaimBatch
+	for _, boxID := range keeper.getClaimingBoxIds(ctx) {
+		if limit <= 0 {
+			break
 		}
-		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &boxDeposit)
-		address = GetAddressFromKeyAddressDeposit(iterator.Key())
-		share := boxDeposit.Amount.Quo(box.Deposit.Price)
-		_, err := keeper.ck.SubtractCoins(ctx, address, sdk.NewCoins(sdk.NewCoin(box.BoxId, share)))
+		cacheCtx, write := ctx.CacheContext()
+		box, claims, err := keeper.processDepositBoxClaim(cacheCtx, boxID, limit)
 		if err != nil {
-			return err
+			// a box that can not be processed is left to be claimed by its depositors
+			keeper.removeClaimingBox(ctx, boxID)
+			ctx.Logger().With("module", "x/"+types.ModuleName).
+				Error(fmt.Sprintf("depositbox %s auto claim stopped: %s", boxID, err.Error()))
+			continue
 		}
-		if err = keeper.FetchDepositedCoin(ctx, address,
-			sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, boxDeposit.Amount)), box.BoxId); err != nil {
-			return err
+		write()
+		limit -= claims
+		if box != nil {
+			boxes = append(boxes, box)
 		}
-		interest := utils.MulMaxPrecisionByDecimal(box.Deposit.PerCoupon.MulInt(share), box.Deposit.Interest.Decimals)
-		if err = keeper.FetchDepositedCoin(ctx, address,
-			sdk.NewCoins(sdk.NewCoin(box.Deposit.Interest.Token.Denom, interest)), box.BoxId); err != nil {
-			return err
+	}
+	return boxes
+}
+
+//Claims at most limit deposits of a box, a panic is returned as an error like in ProcessBoxQueueItem
+func (keeper Keeper) processDepositBoxClaim(ctx sdk.Context, boxID string, limit int) (box *types.BoxInfo, claims int, err sdk.Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			box = nil
+			err = sdk.ErrInternal(fmt.Sprintf("%v", r))
 		}
-		boxDeposit.Interest = interest
-		keeper.setAddressDeposit(ctx, box.BoxId, address, &boxDeposit)
-		totalInterest = totalInterest.Add(interest)
-	}
-	if !totalInterest.Equal(box.Deposit.Interest.Token.Amount) {
-		left := box.Deposit.Interest.Token.Amount.Sub(totalInterest)
-		if err := keeper.FetchDepositedCoin(ctx, address, sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom,
-			left)), box.BoxId); err != nil {
-			return err
+	}()
+	box = keeper.GetBox(ctx, boxID)
+	if box == nil || box.BoxStatus != types.DepositBoxMatured {
+		keeper.removeClaimingBox(ctx, boxID)
+		return nil, 0, nil
+	}
+	addresses := keeper.getDepositAddresses(ctx, boxID, limit)
+	if len(addresses) == 0 {
+		keeper.removeClaimingBox(ctx, boxID)
+		return nil, 0, nil
+	}
+	for _, address := range addresses {
+		if !keeper.ck.GetCoins(ctx, address).AmountOf(boxID).IsPositive() {
+			keeper.removeAddressDeposit(ctx, boxID, address)
+			continue
 		}
-		boxDeposit.Interest = boxDeposit.Interest.Add(left)
-		keeper.setAddressDeposit(ctx, box.BoxId, address, &boxDeposit)
+		claimed := *box
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := keeper.claimDepositBox(cacheCtx, &claimed, address); err != nil {
+			box.Deposit.AutoClaim = false
+			keeper.removeClaimingBox(ctx, boxID)
+			break
+		}
+		write()
+		*box = claimed
+		claims++
 	}
-	keeper.RemoveFromActiveBoxQueue(ctx, box.Deposit.MaturityTime, box.BoxId)
-	box.BoxStatus = types.BoxFinished
-	keeper.setBox(ctx, box)
-	return nil
+	keeper.SetBox(ctx, box)
+	return box, claims, nil
+}
+
+//Returns the addresses of at most limit deposits of a box
+func (keeper Keeper) getDepositAddresses(ctx sdk.Context, boxID string, limit int) []sdk.AccAddress {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyDeposit(boxID))
+	defer iterator.Close()
+	addresses := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid() && len(addresses) < limit; iterator.Next() {
+		addresses = append(addresses, GetAddressFromKeyAddressDeposit(iterator.Key()))
+	}
+	return addresses
+}
+
+//Keys set
+//Set a matured deposit box to be paid by the end blocker
+func (keeper Keeper) SetClaimingBox(ctx sdk.Context, boxID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(boxID)
+	store.Set(KeyClaimingBox(boxID), bz)
+}
+
+//Remove a deposit box paid by the end blocker
+func (keeper Keeper) removeClaimingBox(ctx sdk.Context, boxID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyClaimingBox(boxID))
+}
+
+//Return the ids of the deposit boxes paid by the end blocker
+func (keeper Keeper) getClaimingBoxIds(ctx sdk.Context) []string {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixClaimingBox)
+	defer iterator.Close()
+	boxIDs := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var boxID string
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &boxID)
+		boxIDs = append(boxIDs, boxID)
+	}
+	return boxIDs
 }
 
 //Queries
@@ -367,3 +579,41 @@ func (keeper Keeper) QueryDepositListFromDepositBox(ctx sdk.Context, boxID strin
 	}
 	return list
 }
+
+//Query the unclaimed principal and interest of a matured deposit box, the list covers the certificates
+//still held by the depositors
+func (keeper Keeper) QueryUnclaimedFromDepositBox(ctx sdk.Context, boxID string,
+	accAddress sdk.AccAddress) (types.DepositBoxUnclaimedList, sdk.Error) {
+	box := keeper.GetBox(ctx, boxID)
+	if box == nil {
+		return nil, errors.ErrUnknownBox(boxID)
+	}
+	if box.BoxType != types.Deposit {
+		return nil, errors.ErrNotSupportOperation()
+	}
+	var list = make(types.DepositBoxUnclaimedList, 0)
+	if box.BoxStatus != types.DepositBoxMatured {
+		return list, nil
+	}
+	if accAddress != nil && !accAddress.Empty() {
+		share := keeper.ck.GetCoins(ctx, accAddress).AmountOf(boxID)
+		if share.IsPositive() {
+			list = append(list, types.NewDepositBoxUnclaimed(accAddress, share.Mul(box.Deposit.Price),
+				box.Deposit.GetPayout(share)))
+		}
+		return list, nil
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyDeposit(boxID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		address := GetAddressFromKeyAddressDeposit(iterator.Key())
+		share := keeper.ck.GetCoins(ctx, address).AmountOf(boxID)
+		if !share.IsPositive() {
+			continue
+		}
+		list = append(list, types.NewDepositBoxUnclaimed(address, share.Mul(box.Deposit.Price),
+			box.Deposit.GetPayout(share)))
+	}
+	return list, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+//Process escrow box
+
+func (keeper Keeper) ProcessEscrowBoxCreate(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
+	if err := keeper.SendDepositedCoin(ctx, box.Owner, sdk.Coins{box.TotalAmount.Token}, box.BoxId); err != nil {
+		return err
+	}
+	keeper.InsertActiveBoxQueue(ctx, box.Escrow.ExpireTime, box.BoxId)
+	box.BoxStatus = types.BoxActived
+	return nil
+}
+
+func (keeper Keeper) getActiveEscrowBox(ctx sdk.Context, boxID string) (*types.BoxInfo, sdk.Error) {
+	box := keeper.GetBox(ctx, boxID)
+	if box == nil {
+		return nil, errors.ErrUnknownBox(boxID)
+	}
+	if box.BoxType != types.Escrow {
+		return nil, errors.ErrNotSupportOperation()
+	}
+	if box.BoxStatus != types.BoxActived {
+		return nil, errors.ErrNotAllowedOperation(box.BoxStatus)
+	}
+	return box, nil
+}
+
+//Arbiter or beneficiary, confirming the deal, releases the escrow to the beneficiary
+func (keeper Keeper) ReleaseEscrowBox(ctx sdk.Context, sender sdk.AccAddress, boxID string) (*types.BoxInfo, sdk.Error) {
+	box, err := keeper.getActiveEscrowBox(ctx, boxID)
+	if err != nil {
+		return nil, err
+	}
+	if !box.Escrow.CanRelease(sender) {
+		return nil, errors.ErrEscrowPartyMismatch(boxID, types.Release)
+	}
+	if err := keeper.closeEscrowBox(ctx, box, box.Escrow.Beneficiary, types.BoxFinished); err != nil {
+		return nil, err
+	}
+	return box, nil
+}
+
+//Arbiter refunds the escrow to the owner
+func (keeper Keeper) RefundEscrowBox(ctx sdk.Context, sender sdk.AccAddress, boxID string) (*types.BoxInfo, sdk.Error) {
+	box, err := keeper.getActiveEscrowBox(ctx, boxID)
+	if err != nil {
+		return nil, err
+	}
+	if !box.Escrow.CanRefund(sender) {
+		return nil, errors.ErrEscrowPartyMismatch(boxID, types.Refund)
+	}
+	if err := keeper.closeEscrowBox(ctx, box, box.Owner, types.BoxClosed); err != nil {
+		return nil, err
+	}
+	return box, nil
+}
+
+//Refunds an escrow box to the owner when it expires
+func (keeper Keeper) ProcessEscrowBoxByEndBlocker(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
+	if box.BoxStatus != types.BoxActived {
+		keeper.RemoveFromActiveBoxQueue(ctx, box.Escrow.ExpireTime, box.BoxId)
+		return nil
+	}
+	return keeper.closeEscrowBox(ctx, box, box.Owner, types.BoxClosed)
+}
+
+func (keeper Keeper) closeEscrowBox(ctx sdk.Context, box *types.BoxInfo, toAddr sdk.AccAddress, status string) sdk.Error {
+	if err := keeper.FetchDepositedCoin(ctx, toAddr, sdk.Coins{box.TotalAmount.Token}, box.BoxId); err != nil {
+		return err
+	}
+	keeper.RemoveFromActiveBoxQueue(ctx, box.Escrow.ExpireTime, box.BoxId)
+	box.BoxStatus = status
+	keeper.SetBox(ctx, box)
+	return nil
+}
+
+//Returns the escrow boxes in which an address is the beneficiary or the arbiter
+func (keeper Keeper) GetEscrowBoxesByParty(ctx sdk.Context, accAddress sdk.AccAddress) []*types.BoxInfo {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyBoxType(types.Escrow))
+	defer iterator.Close()
+
+	list := make([]*types.BoxInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var box types.BoxInfo
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &box)
+		if box.Escrow.Beneficiary.Equals(accAddress) || box.Escrow.Arbiter.Equals(accAddress) {
+			list = append(list, &box)
+		}
+	}
+	return list
+}
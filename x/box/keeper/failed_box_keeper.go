@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+//Process active box queue
+
+//Returns the due entries of the active box queue, at most limit of them, the others carry over to the next blocks
+func (keeper Keeper) GetDueBoxQueueItems(ctx sdk.Context, endTime int64, limit uint64) []types.BoxQueueItem {
+	iterator := keeper.ActiveBoxQueueIterator(ctx, endTime)
+	defer iterator.Close()
+	items := make([]types.BoxQueueItem, 0)
+	for ; iterator.Valid() && uint64(len(items)) < limit; iterator.Next() {
+		keys := strings.SplitN(string(iterator.Key()), string(KeyDelimiter), 3)
+		time, err := strconv.ParseInt(keys[1], 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		var boxIdStr string
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &boxIdStr)
+		items = append(items, types.NewBoxQueueItem(time, boxIdStr))
+	}
+	return items
+}
+
+//Process an entry of the active box queue, a panic while processing is returned as an error
+func (keeper Keeper) ProcessBoxQueueItem(ctx sdk.Context, boxIdStr string) (box *types.BoxInfo, seq int, err sdk.Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			box = nil
+			err = sdk.ErrInternal(fmt.Sprintf("%v", r))
+		}
+	}()
+	boxID := boxIdStr
+	if strings.Contains(boxID, types.KeyDelimiterString) {
+		boxIDs := strings.Split(boxID, types.KeyDelimiterString)
+		boxID = boxIDs[0]
+		seq, _ = strconv.Atoi(boxIDs[1])
+	}
+	box = keeper.GetBox(ctx, boxID)
+	if box == nil {
+		return nil, seq, errors.ErrUnknownBox(boxID)
+	}
+	switch box.BoxType {
+	case types.Lock:
+		err = keeper.ProcessLockBoxByEndBlocker(ctx, box)
+	case types.Deposit:
+		err = keeper.ProcessDepositBoxByEndBlocker(ctx, box)
+	case types.Future:
+		err = keeper.ProcessFutureBoxByEndBlocker(ctx, box, seq)
+	case types.Escrow:
+		err = keeper.ProcessEscrowBoxByEndBlocker(ctx, box)
+	case types.Sale:
+		err = keeper.ProcessSaleBoxByEndBlocker(ctx, box)
+	default:
+		err = errors.ErrUnknownBoxType()
+	}
+	if err != nil {
+		return nil, seq, err
+	}
+	return box, seq, nil
+}
+
+//Moves an entry of the active box queue to the failed box store
+func (keeper Keeper) FailBoxQueueItem(ctx sdk.Context, item types.BoxQueueItem, reason string) {
+	keeper.RemoveFromActiveBoxQueue(ctx, item.EndTime, item.BoxId)
+	keeper.SetFailedBox(ctx, types.NewBoxFailure(item, ctx.BlockHeight(), reason))
+}
+
+//Owner or the retry authority of the params processes the failed entries of a box again,
+//they stay failed if processing still fails
+func (keeper Keeper) RetryFailedBox(ctx sdk.Context, sender sdk.AccAddress, boxID string) (*types.BoxInfo, sdk.Error) {
+	box := keeper.GetBox(ctx, boxID)
+	if box == nil {
+		return nil, errors.ErrUnknownBox(boxID)
+	}
+	authority := keeper.GetBoxConfigParams(ctx).RetryAuthority
+	if !box.Owner.Equals(sender) && (authority.Empty() || !authority.Equals(sender)) {
+		return nil, errors.ErrOwnerMismatch(boxID)
+	}
+	var err sdk.Error
+	failures := keeper.GetFailedBoxes(ctx, boxID)
+	if len(failures) == 0 {
+		return nil, errors.ErrNoFailedBox(boxID)
+	}
+	for _, failure := range failures {
+		keeper.removeFailedBox(ctx, failure.BoxId)
+		if box, _, err = keeper.ProcessBoxQueueItem(ctx, failure.BoxId); err != nil {
+			return nil, err
+		}
+	}
+	return box, nil
+}
+
+//Keys set
+//Set a failed entry of the active box queue
+func (keeper Keeper) SetFailedBox(ctx sdk.Context, failure types.BoxFailure) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(failure)
+	store.Set(KeyFailedBox(failure.BoxId), bz)
+}
+
+//Remove a failed entry of the active box queue
+func (keeper Keeper) removeFailedBox(ctx sdk.Context, boxIdStr string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyFailedBox(boxIdStr))
+}
+
+//Returns the failed entries of a box in time order
+func (keeper Keeper) GetFailedBoxes(ctx sdk.Context, boxID string) types.BoxFailures {
+	failures := make(types.BoxFailures, 0)
+	for _, failure := range keeper.getFailedBoxesByPrefix(ctx, KeyFailedBox(boxID)) {
+		if strings.Split(failure.BoxId, types.KeyDelimiterString)[0] == boxID {
+			failures = append(failures, failure)
+		}
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[i].EndTime < failures[j].EndTime
+	})
+	return failures
+}
+
+//Returns all the failed entries of the active box queue
+func (keeper Keeper) GetAllFailedBoxes(ctx sdk.Context) types.BoxFailures {
+	return keeper.getFailedBoxesByPrefix(ctx, PrefixFailedBox)
+}
+
+func (keeper Keeper) getFailedBoxesByPrefix(ctx sdk.Context, prefix []byte) types.BoxFailures {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	failures := make(types.BoxFailures, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var failure types.BoxFailure
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &failure)
+		failures = append(failures, failure)
+	}
+	return failures
+}
@@ -8,16 +8,11 @@ import (
 	"github.com/hashgard/hashgard/x/box/errors"
 	"github.com/hashgard/hashgard/x/box/types"
 	"github.com/hashgard/hashgard/x/box/utils"
-	issueerr "github.com/hashgard/hashgard/x/issue/errors"
 )
 
 //Process Future box
 
 func (keeper Keeper) ProcessFutureBoxCreate(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
-	coinIssueInfo := keeper.GetIssueKeeper().GetIssue(ctx, box.TotalAmount.Token.Denom)
-	if coinIssueInfo == nil {
-		return issueerr.ErrUnknownIssue(box.Deposit.Interest.Token.Denom)
-	}
 	box.BoxStatus = types.BoxDepositing
 	keeper.InsertActiveBoxQueue(ctx, box.Future.TimeLine[0], keeper.getFutureBoxSeqString(box, 0))
 	return nil
@@ -67,7 +62,7 @@ func (keeper Keeper) depositToFutureBox(ctx sdk.Context, box *types.BoxInfo, sen
 		keeper.RemoveFromActiveBoxQueue(ctx, box.Future.TimeLine[0], keeper.getFutureBoxSeqString(box, 0))
 	}
 	keeper.addAddressDeposit(ctx, box.BoxId, sender, types.NewBoxDeposit(deposit.Amount))
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return nil
 }
 func (keeper Keeper) fetchDepositFromFutureBox(ctx sdk.Context, box *types.BoxInfo, sender sdk.AccAddress, deposit sdk.Coin) sdk.Error {
@@ -94,9 +89,9 @@ func (keeper Keeper) fetchDepositFromFutureBox(ctx sdk.Context, box *types.BoxIn
 	if boxDeposit.Amount.IsZero() {
 		keeper.removeAddressDeposit(ctx, box.BoxId, sender)
 	} else {
-		keeper.setAddressDeposit(ctx, box.BoxId, sender, boxDeposit)
+		keeper.SetAddressDeposit(ctx, box.BoxId, sender, boxDeposit)
 	}
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return nil
 }
 func (keeper Keeper) processFutureBoxDistribute(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
@@ -193,6 +188,6 @@ func (keeper Keeper) processFutureBoxActiveByEndBlocker(ctx sdk.Context, box *ty
 		box.BoxStatus = types.BoxFinished
 	}
 	keeper.RemoveFromActiveBoxQueue(ctx, timeLine, keeper.getFutureBoxSeqString(box, seq))
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return nil
 }
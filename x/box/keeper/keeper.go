@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -12,7 +14,7 @@ import (
 	"github.com/hashgard/hashgard/x/box/errors"
 	boxparams "github.com/hashgard/hashgard/x/box/params"
 	"github.com/hashgard/hashgard/x/box/types"
-	issueerr "github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/box/utils"
 )
 
 // Parameter store key
@@ -74,9 +76,24 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
 	}
 }
 
-func (keeper Keeper) getDepositedCoinsAddress(boxID string) sdk.AccAddress {
+//Returns the address of the account holding the coins deposited in a box
+func GetDepositedCoinsAddress(boxID string) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("boxDepositedCoins:%s", boxID))))
+}
+func (keeper Keeper) getDepositedCoinsAddress(boxID string) sdk.AccAddress {
+	return GetDepositedCoinsAddress(boxID)
+}
 
+//Set the box of its deposited coins address
+func (keeper Keeper) SetDepositedCoinsAddress(ctx sdk.Context, boxID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyDepositedCoinsAddress(keeper.getDepositedCoinsAddress(boxID)), []byte(boxID))
+}
+
+//Returns whether the address holds the deposited coins of a box
+func (keeper Keeper) IsDepositedCoinsAddress(ctx sdk.Context, accAddress sdk.AccAddress) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(KeyDepositedCoinsAddress(accAddress))
 }
 func (keeper Keeper) SendDepositedCoin(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins, boxID string) sdk.Error {
 	toAddr := keeper.getDepositedCoinsAddress(boxID)
@@ -96,26 +113,26 @@ func (keeper Keeper) GetDepositedCoins(ctx sdk.Context, boxID string) sdk.Coins
 
 //Keys set
 //Set box
-func (keeper Keeper) setBox(ctx sdk.Context, box *types.BoxInfo) {
+func (keeper Keeper) SetBox(ctx sdk.Context, box *types.BoxInfo) {
 	store := ctx.KVStore(keeper.storeKey)
 	store.Set(KeyBox(box.BoxId), keeper.cdc.MustMarshalBinaryLengthPrefixed(box))
 }
 
 //Set address
-func (keeper Keeper) setAddress(ctx sdk.Context, boxType string, accAddress sdk.AccAddress, boxIDs []string) {
+func (keeper Keeper) SetAddress(ctx sdk.Context, boxType string, accAddress sdk.AccAddress, boxIDs []string) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(boxIDs)
 	store.Set(KeyAddress(boxType, accAddress), bz)
 }
 
 //Set name
-func (keeper Keeper) setName(ctx sdk.Context, boxType string, name string, boxIDs []string) {
+func (keeper Keeper) SetName(ctx sdk.Context, boxType string, name string, boxIDs []string) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(boxIDs)
 	store.Set(KeyName(boxType, name), bz)
 }
 
-func (keeper Keeper) setAddressDeposit(ctx sdk.Context, boxID string, accAddress sdk.AccAddress, boxDeposit *types.BoxDeposit) {
+func (keeper Keeper) SetAddressDeposit(ctx sdk.Context, boxID string, accAddress sdk.AccAddress, boxDeposit *types.BoxDeposit) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(boxDeposit)
 	store.Set(KeyAddressDeposit(boxID, accAddress), bz)
@@ -124,7 +141,7 @@ func (keeper Keeper) setAddressDeposit(ctx sdk.Context, boxID string, accAddress
 //Add address deposit
 func (keeper Keeper) addAddressDeposit(ctx sdk.Context, boxID string, accAddress sdk.AccAddress, boxDeposit *types.BoxDeposit) {
 	boxDeposit.Amount = boxDeposit.Amount.Add(keeper.GetDepositByAddress(ctx, boxID, accAddress).Amount)
-	keeper.setAddressDeposit(ctx, boxID, accAddress, boxDeposit)
+	keeper.SetAddressDeposit(ctx, boxID, accAddress, boxDeposit)
 }
 
 //Keys remove
@@ -165,6 +182,15 @@ func (keeper Keeper) GetBox(ctx sdk.Context, boxID string) *types.BoxInfo {
 	return &box
 }
 
+//Return box by the denom of its coins, future box coins carry a seq suffix
+func (keeper Keeper) GetBoxByDenom(ctx sdk.Context, denom string) *types.BoxInfo {
+	box := keeper.GetBox(ctx, denom)
+	if box == nil && utils.GetBoxTypeByValue(denom) == types.Future && len(denom) > 2 {
+		box = keeper.GetBox(ctx, utils.GetBoxIdFromFutureBoxSeq(denom))
+	}
+	return box
+}
+
 //Return box by boxID and and check owner
 func (keeper Keeper) GetBoxByOwner(ctx sdk.Context, sender sdk.AccAddress, boxID string) (*types.BoxInfo, sdk.Error) {
 	box := keeper.GetBox(ctx, boxID)
@@ -201,6 +227,37 @@ func (keeper Keeper) CheckDepositByAddress(ctx sdk.Context, boxID string, accAdd
 	return true
 }
 
+//Returns all boxes in the store
+func (keeper Keeper) GetAllBoxes(ctx sdk.Context) []types.BoxInfo {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyBox())
+	defer iterator.Close()
+
+	boxes := make([]types.BoxInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var box types.BoxInfo
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &box)
+		boxes = append(boxes, box)
+	}
+	return boxes
+}
+
+//Returns all address deposits in the store
+func (keeper Keeper) GetAllDeposits(ctx sdk.Context) []types.AddressBoxDeposit {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyAddressDeposit())
+	defer iterator.Close()
+
+	deposits := make([]types.AddressBoxDeposit, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys := strings.Split(string(iterator.Key()), string(KeyDelimiter))
+		var boxDeposit types.BoxDeposit
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &boxDeposit)
+		deposits = append(deposits, types.NewAddressBoxDeposit(keys[1], GetAddressFromKeyAddressDeposit(iterator.Key()), boxDeposit))
+	}
+	return deposits
+}
+
 //Queries
 
 //Search box by name
@@ -258,12 +315,8 @@ func (keeper Keeper) List(ctx sdk.Context, params boxparams.BoxQueryParams) []*t
 
 //Create a box
 func (keeper Keeper) CreateBox(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
-	coinIssueInfo := keeper.GetIssueKeeper().GetIssue(ctx, box.TotalAmount.Token.Denom)
-	if coinIssueInfo == nil {
-		return issueerr.ErrUnknownIssue(box.Deposit.Interest.Token.Denom)
-	}
-	if box.TotalAmount.Decimals != coinIssueInfo.GetDecimals() {
-		return errors.ErrDecimalsNotValid(box.TotalAmount.Decimals)
+	if err := keeper.CheckBoxToken(ctx, box.TotalAmount); err != nil {
+		return err
 	}
 	store := ctx.KVStore(keeper.storeKey)
 	id, err := keeper.getNewBoxID(store, box.BoxType)
@@ -272,6 +325,7 @@ func (keeper Keeper) CreateBox(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
 	}
 	box.BoxId = KeyBoxIdStr(box.BoxType, id)
 	box.CreatedTime = time.Now().Unix()
+	keeper.SetDepositedCoinsAddress(ctx, box.BoxId)
 
 	switch box.BoxType {
 	case types.Lock:
@@ -280,6 +334,12 @@ func (keeper Keeper) CreateBox(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
 		err = keeper.ProcessDepositBoxCreate(ctx, box)
 	case types.Future:
 		err = keeper.ProcessFutureBoxCreate(ctx, box)
+	case types.Vesting:
+		err = keeper.ProcessVestingBoxCreate(ctx, box)
+	case types.Escrow:
+		err = keeper.ProcessEscrowBoxCreate(ctx, box)
+	case types.Sale:
+		err = keeper.ProcessSaleBoxCreate(ctx, box)
 	default:
 		return errors.ErrUnknownBoxType()
 	}
@@ -288,11 +348,11 @@ func (keeper Keeper) CreateBox(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
 	}
 	boxIDs := keeper.GetBoxIdsByAddress(ctx, box.BoxType, box.Owner)
 	boxIDs = append(boxIDs, box.BoxId)
-	keeper.setAddress(ctx, box.BoxType, box.Owner, boxIDs)
+	keeper.SetAddress(ctx, box.BoxType, box.Owner, boxIDs)
 
 	boxIDs = keeper.GetBoxIdsByName(ctx, box.BoxType, box.Name)
 	boxIDs = append(boxIDs, box.BoxId)
-	keeper.setName(ctx, box.BoxType, box.Name, boxIDs)
+	keeper.SetName(ctx, box.BoxType, box.Name, boxIDs)
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(box)
 	store.Set(KeyBox(box.BoxId), bz)
 	return nil
@@ -311,17 +371,38 @@ func (keeper Keeper) ProcessDepositToBox(ctx sdk.Context, boxID string, sender s
 		return box, keeper.processDepositBoxDeposit(ctx, box, sender, deposit, operation)
 	case types.Future:
 		return box, keeper.processFutureBoxDeposit(ctx, box, sender, deposit, operation)
+	case types.Sale:
+		return box, keeper.processSaleBoxDeposit(ctx, box, sender, deposit, operation)
 	}
 	return nil, errors.ErrUnknownBoxType()
 }
 
+//Claim the coins of a box that pays out on request
+func (keeper Keeper) ClaimBox(ctx sdk.Context, sender sdk.AccAddress, boxID string) (*types.BoxInfo, sdk.Coins, sdk.Error) {
+	box := keeper.GetBox(ctx, boxID)
+	if box == nil {
+		return nil, nil, errors.ErrUnknownBox(boxID)
+	}
+	switch box.BoxType {
+	case types.Vesting:
+		box, claim, err := keeper.ClaimVestingBox(ctx, sender, boxID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return box, sdk.NewCoins(claim), nil
+	case types.Deposit:
+		return keeper.ClaimDepositBox(ctx, sender, boxID)
+	}
+	return nil, nil, errors.ErrNotSupportOperation()
+}
+
 func (keeper Keeper) SetBoxDescription(ctx sdk.Context, boxID string, sender sdk.AccAddress, description []byte) (*types.BoxInfo, sdk.Error) {
 	box, err := keeper.GetBoxByOwner(ctx, sender, boxID)
 	if err != nil {
 		return box, err
 	}
 	box.Description = string(description)
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return box, nil
 }
 func (keeper Keeper) DisableFeature(ctx sdk.Context, sender sdk.AccAddress, boxID string, feature string) (*types.BoxInfo, sdk.Error) {
@@ -337,14 +418,31 @@ func (keeper Keeper) DisableFeature(ctx sdk.Context, sender sdk.AccAddress, boxI
 	}
 }
 func (keeper Keeper) disableTrade(ctx sdk.Context, sender sdk.AccAddress, boxInfo *types.BoxInfo) sdk.Error {
-	if boxInfo.GetBoxType() == types.Lock {
+	if !types.IsTradable(boxInfo.GetBoxType()) {
 		return errors.ErrNotSupportOperation()
 	}
 	if !boxInfo.IsTradeDisabled() {
 		return nil
 	}
 	boxInfo.TradeDisabled = false
-	keeper.setBox(ctx, boxInfo)
+	keeper.SetBox(ctx, boxInfo)
+	return nil
+}
+
+//Check that none of the coins belongs to a box whose trade is disabled
+func (keeper Keeper) CheckTrade(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		if !utils.IsBoxId(coin.Denom) {
+			continue
+		}
+		box := keeper.GetBoxByDenom(ctx, coin.Denom)
+		if box == nil {
+			return errors.ErrUnknownBox(coin.Denom)
+		}
+		if box.IsTradeDisabled() {
+			return errors.ErrCanNotTrade(box.BoxId)
+		}
+	}
 	return nil
 }
 
@@ -392,6 +490,26 @@ func (keeper Keeper) InsertActiveBoxQueue(ctx sdk.Context, endTime int64, boxIdS
 	store.Set(KeyActiveBoxQueue(endTime, boxIdStr), bz)
 }
 
+// Returns all the entries of the active box queue
+func (keeper Keeper) GetAllActiveBoxQueueItems(ctx sdk.Context) []types.BoxQueueItem {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixActiveQueue)
+	defer iterator.Close()
+
+	items := make([]types.BoxQueueItem, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys := strings.SplitN(string(iterator.Key()), string(KeyDelimiter), 3)
+		endTime, err := strconv.ParseInt(keys[1], 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		var boxIdStr string
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &boxIdStr)
+		items = append(items, types.NewBoxQueueItem(endTime, boxIdStr))
+	}
+	return items
+}
+
 // removes a boxID from the Active box Queue
 func (keeper Keeper) RemoveFromActiveBoxQueue(ctx sdk.Context, endTime int64, boxIdStr string) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -16,6 +16,8 @@ import (
 var (
 	KeyDelimiter      = []byte(types.KeyDelimiterString)
 	PrefixActiveQueue = []byte("active")
+	PrefixClaimingBox = []byte("claiming:")
+	PrefixFailedBox   = []byte("failed:")
 )
 
 func KeyBoxIdStr(boxType string, seq uint64) string {
@@ -41,16 +43,41 @@ func KeyAddressDeposit(boxID string, accAddress sdk.AccAddress) []byte {
 	return []byte(fmt.Sprintf("deposit:%s:%s", boxID, accAddress.String()))
 }
 
+// Key for getting the box of a deposited coins address
+func KeyDepositedCoinsAddress(accAddress sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("depositedCoinsAddress:%s", accAddress.String()))
+}
+
 func GetAddressFromKeyAddressDeposit(keyAddressDeposit []byte) sdk.AccAddress {
 	str := fmt.Sprintf("%s", keyAddressDeposit)
 	keys := strings.Split(str, ":")
 	address, _ := sdk.AccAddressFromBech32(keys[2])
 	return address
 }
+// Key prefixes for iterating all records of a kind
+func PrefixKeyBox() []byte {
+	return []byte("ids:")
+}
+func PrefixKeyBoxType(boxType string) []byte {
+	return []byte(fmt.Sprintf("ids:%s:", boxType))
+}
+func PrefixKeyAddressDeposit() []byte {
+	return []byte("deposit:")
+}
 func PrefixKeyDeposit(boxID string) []byte {
 	return []byte(fmt.Sprintf("deposit:%s", boxID))
 }
 
+// Returns the key for a matured deposit box paid by the end blocker
+func KeyClaimingBox(boxIdStr string) []byte {
+	return []byte(fmt.Sprintf("claiming:%s", boxIdStr))
+}
+
+// Returns the key for a failed entry of the activeQueue
+func KeyFailedBox(boxIdStr string) []byte {
+	return []byte(fmt.Sprintf("failed:%s", boxIdStr))
+}
+
 // Returns the key for a boxID in the activeQueue
 func PrefixActiveBoxQueueTime(endTime int64) []byte {
 	return []byte(fmt.Sprintf("active:%d", endTime))
@@ -32,6 +32,6 @@ func (keeper Keeper) ProcessLockBoxByEndBlocker(ctx sdk.Context, box *types.BoxI
 	}
 	keeper.RemoveFromActiveBoxQueue(ctx, box.Lock.EndTime, box.BoxId)
 	box.BoxStatus = types.LockBoxUnlocked
-	keeper.setBox(ctx, box)
+	keeper.SetBox(ctx, box)
 	return nil
 }
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+//Process sale box
+
+func (keeper Keeper) ProcessSaleBoxCreate(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
+	if err := keeper.SendDepositedCoin(ctx, box.Owner, sdk.Coins{box.TotalAmount.Token}, box.BoxId); err != nil {
+		return err
+	}
+	box.BoxStatus = types.BoxCreated
+	box.Sale.TotalDeposit = sdk.ZeroInt()
+	box.Sale.Raised = sdk.ZeroInt()
+	box.Sale.Sold = sdk.ZeroInt()
+	keeper.InsertActiveBoxQueue(ctx, box.Sale.StartTime, box.BoxId)
+	return nil
+}
+func (keeper Keeper) processSaleBoxDeposit(ctx sdk.Context, box *types.BoxInfo, sender sdk.AccAddress, deposit sdk.Coin, operation string) sdk.Error {
+	if box.Sale.Price.Denom != deposit.Denom {
+		return errors.ErrAmountNotValid(deposit.Denom)
+	}
+	switch operation {
+	case types.DepositTo:
+		return keeper.depositToSaleBox(ctx, box, sender, deposit)
+	case types.Fetch:
+		return keeper.fetchDepositFromSaleBox(ctx, box, sender, deposit)
+	}
+	return errors.ErrUnknownOperation()
+}
+func (keeper Keeper) depositToSaleBox(ctx sdk.Context, box *types.BoxInfo, sender sdk.AccAddress, deposit sdk.Coin) sdk.Error {
+	if err := keeper.SendDepositedCoin(ctx, sender, sdk.Coins{deposit}, box.BoxId); err != nil {
+		return err
+	}
+	keeper.addAddressDeposit(ctx, box.BoxId, sender, types.NewBoxDeposit(deposit.Amount))
+	box.Sale.TotalDeposit = box.Sale.TotalDeposit.Add(deposit.Amount)
+	keeper.SetBox(ctx, box)
+	return nil
+}
+func (keeper Keeper) fetchDepositFromSaleBox(ctx sdk.Context, box *types.BoxInfo, sender sdk.AccAddress, deposit sdk.Coin) sdk.Error {
+	boxDeposit := keeper.GetDepositByAddress(ctx, box.BoxId, sender)
+	if boxDeposit.Amount.LT(deposit.Amount) {
+		return errors.ErrNotEnoughAmount()
+	}
+	if err := keeper.FetchDepositedCoin(ctx, sender, sdk.Coins{deposit}, box.BoxId); err != nil {
+		return err
+	}
+	boxDeposit.Amount = boxDeposit.Amount.Sub(deposit.Amount)
+	if boxDeposit.Amount.IsZero() {
+		keeper.removeAddressDeposit(ctx, box.BoxId, sender)
+	} else {
+		keeper.SetAddressDeposit(ctx, box.BoxId, sender, boxDeposit)
+	}
+	box.Sale.TotalDeposit = box.Sale.TotalDeposit.Sub(deposit.Amount)
+	keeper.SetBox(ctx, box)
+	return nil
+}
+func (keeper Keeper) ProcessSaleBoxByEndBlocker(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
+	switch box.BoxStatus {
+	case types.BoxCreated:
+		keeper.RemoveFromActiveBoxQueue(ctx, box.Sale.StartTime, box.BoxId)
+		box.BoxStatus = types.BoxDepositing
+		keeper.InsertActiveBoxQueue(ctx, box.Sale.EndTime, box.BoxId)
+		keeper.SetBox(ctx, box)
+		return nil
+	case types.BoxDepositing:
+		box.BoxStatus = types.SaleBoxSettling
+		return keeper.settleSaleBoxByEndBlocker(ctx, box)
+	case types.SaleBoxSettling:
+		return keeper.settleSaleBoxByEndBlocker(ctx, box)
+	default:
+		return errors.ErrNotAllowedOperation(box.BoxStatus)
+	}
+}
+
+//Settles at most BoxMaxClaimBatch deposits of an ended sale per block, the box is queued again at its end time
+//until every deposit is settled. Each buyer is paid on its own, a buyer who can not receive the token,
+//being frozen or not allowlisted, gets the whole payment back
+func (keeper Keeper) settleSaleBoxByEndBlocker(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
+	keeper.RemoveFromActiveBoxQueue(ctx, box.Sale.EndTime, box.BoxId)
+	success := box.Sale.TotalDeposit.GTE(box.Sale.SoftCap)
+	for _, address := range keeper.getDepositAddresses(ctx, box.BoxId, types.BoxMaxClaimBatch) {
+		boxDeposit := keeper.GetDepositByAddress(ctx, box.BoxId, address)
+		keeper.removeAddressDeposit(ctx, box.BoxId, address)
+		if success {
+			cacheCtx, write := ctx.CacheContext()
+			accepted, tokens, err := keeper.paySaleBoxDeposit(cacheCtx, box, address, boxDeposit.Amount)
+			if err == nil {
+				write()
+				box.Sale.Raised = box.Sale.Raised.Add(accepted)
+				box.Sale.Sold = box.Sale.Sold.Add(tokens)
+				continue
+			}
+		}
+		if err := keeper.FetchDepositedCoin(ctx, address,
+			sdk.NewCoins(sdk.NewCoin(box.Sale.Price.Denom, boxDeposit.Amount)), box.BoxId); err != nil {
+			return err
+		}
+	}
+	if len(keeper.getDepositAddresses(ctx, box.BoxId, 1)) > 0 {
+		keeper.InsertActiveBoxQueue(ctx, box.Sale.EndTime, box.BoxId)
+		keeper.SetBox(ctx, box)
+		return nil
+	}
+
+	unsold := box.TotalAmount.Token.Amount.Sub(box.Sale.Sold)
+	if err := keeper.FetchDepositedCoin(ctx, box.Owner, sdk.NewCoins(
+		sdk.NewCoin(box.TotalAmount.Token.Denom, unsold),
+		sdk.NewCoin(box.Sale.Price.Denom, box.Sale.Raised)), box.BoxId); err != nil {
+		return err
+	}
+	if success {
+		box.BoxStatus = types.BoxActived
+	} else {
+		box.BoxStatus = types.BoxClosed
+	}
+	keeper.SetBox(ctx, box)
+	return nil
+}
+
+//Pays a buyer the tokens of the accepted part of its payment and refunds the rest
+func (keeper Keeper) paySaleBoxDeposit(ctx sdk.Context, box *types.BoxInfo, address sdk.AccAddress,
+	deposit sdk.Int) (accepted sdk.Int, tokens sdk.Int, err sdk.Error) {
+	accepted = box.Sale.GetAcceptedAmount(deposit)
+	tokens = box.Sale.GetTokenAmount(accepted, box.TotalAmount.Decimals)
+	if err = keeper.FetchDepositedCoin(ctx, address,
+		sdk.NewCoins(sdk.NewCoin(box.TotalAmount.Token.Denom, tokens)), box.BoxId); err != nil {
+		return
+	}
+	err = keeper.FetchDepositedCoin(ctx, address,
+		sdk.NewCoins(sdk.NewCoin(box.Sale.Price.Denom, deposit.Sub(accepted))), box.BoxId)
+	return
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+//Process vesting box
+
+func (keeper Keeper) ProcessVestingBoxCreate(ctx sdk.Context, box *types.BoxInfo) sdk.Error {
+	if err := keeper.SendDepositedCoin(ctx, box.Owner, sdk.Coins{box.TotalAmount.Token}, box.BoxId); err != nil {
+		return err
+	}
+	box.Vesting.Claimed = sdk.ZeroInt()
+	box.Vesting.RevokeTime = 0
+	box.BoxStatus = types.BoxActived
+	return nil
+}
+
+func (keeper Keeper) getVestingBox(ctx sdk.Context, boxID string) (*types.BoxInfo, sdk.Error) {
+	box := keeper.GetBox(ctx, boxID)
+	if box == nil {
+		return nil, errors.ErrUnknownBox(boxID)
+	}
+	if box.BoxType != types.Vesting {
+		return nil, errors.ErrNotSupportOperation()
+	}
+	return box, nil
+}
+
+//Get the vested, claimed and claimable amount of a vesting box at the block time
+func (keeper Keeper) GetVestingBoxClaimable(ctx sdk.Context, boxID string) (*types.VestingBoxClaimable, sdk.Error) {
+	box, err := keeper.getVestingBox(ctx, boxID)
+	if err != nil {
+		return nil, err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	denom := box.TotalAmount.Token.Denom
+	vested := box.Vesting.GetVestedAmount(box.TotalAmount.Token.Amount, now)
+	return &types.VestingBoxClaimable{
+		BoxId:       box.BoxId,
+		Beneficiary: box.Vesting.Beneficiary,
+		Time:        now,
+		Vested:      sdk.NewCoin(denom, vested),
+		Claimed:     sdk.NewCoin(denom, box.Vesting.Claimed),
+		Claimable:   sdk.NewCoin(denom, vested.Sub(box.Vesting.Claimed)),
+	}, nil
+}
+
+//Beneficiary claims the vested amount of a vesting box
+func (keeper Keeper) ClaimVestingBox(ctx sdk.Context, sender sdk.AccAddress, boxID string) (*types.BoxInfo, sdk.Coin, sdk.Error) {
+	box, err := keeper.getVestingBox(ctx, boxID)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	if !box.Vesting.Beneficiary.Equals(sender) {
+		return nil, sdk.Coin{}, errors.ErrBeneficiaryMismatch(boxID)
+	}
+	if box.BoxStatus != types.BoxActived {
+		return nil, sdk.Coin{}, errors.ErrNotAllowedOperation(box.BoxStatus)
+	}
+	amount := box.Vesting.GetClaimableAmount(box.TotalAmount.Token.Amount, ctx.BlockHeader().Time.Unix())
+	if !amount.IsPositive() {
+		return nil, sdk.Coin{}, errors.ErrNotEnoughAmount()
+	}
+	claim := sdk.NewCoin(box.TotalAmount.Token.Denom, amount)
+	if err := keeper.FetchDepositedCoin(ctx, sender, sdk.NewCoins(claim), box.BoxId); err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	box.Vesting.Claimed = box.Vesting.Claimed.Add(amount)
+	keeper.finishVestingBox(box)
+	keeper.SetBox(ctx, box)
+	return box, claim, nil
+}
+
+//Owner revokes a revocable vesting box, the unvested amount is returned to the owner
+func (keeper Keeper) RevokeVestingBox(ctx sdk.Context, sender sdk.AccAddress, boxID string) (*types.BoxInfo, sdk.Coin, sdk.Error) {
+	box, err := keeper.GetBoxByOwner(ctx, sender, boxID)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	if box.BoxType != types.Vesting || !box.Vesting.Revocable {
+		return nil, sdk.Coin{}, errors.ErrNotSupportOperation()
+	}
+	if box.BoxStatus != types.BoxActived || box.Vesting.IsRevoked() {
+		return nil, sdk.Coin{}, errors.ErrNotAllowedOperation(box.BoxStatus)
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	if now >= box.Vesting.EndTime {
+		return nil, sdk.Coin{}, errors.ErrTimeNotValid("EndTime")
+	}
+	box.Vesting.RevokeTime = now
+	unvested := box.TotalAmount.Token.Amount.Sub(box.Vesting.GetFinalAmount(box.TotalAmount.Token.Amount))
+	refund := sdk.NewCoin(box.TotalAmount.Token.Denom, unvested)
+	if unvested.IsPositive() {
+		if err := keeper.FetchDepositedCoin(ctx, box.Owner, sdk.NewCoins(refund), box.BoxId); err != nil {
+			return nil, sdk.Coin{}, err
+		}
+	}
+	keeper.finishVestingBox(box)
+	keeper.SetBox(ctx, box)
+	return box, refund, nil
+}
+
+func (keeper Keeper) finishVestingBox(box *types.BoxInfo) {
+	if box.Vesting.Claimed.GTE(box.Vesting.GetFinalAmount(box.TotalAmount.Token.Amount)) {
+		box.BoxStatus = types.BoxFinished
+	}
+}
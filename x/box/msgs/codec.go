@@ -12,10 +12,19 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgLockBox{}, "box/MsgLockBox", nil)
 	cdc.RegisterConcrete(MsgDepositBox{}, "box/MsgDepositBox", nil)
 	cdc.RegisterConcrete(MsgFutureBox{}, "box/MsgFutureBox", nil)
+	cdc.RegisterConcrete(MsgVestingBox{}, "box/MsgVestingBox", nil)
+	cdc.RegisterConcrete(MsgEscrowBox{}, "box/MsgEscrowBox", nil)
+	cdc.RegisterConcrete(MsgSaleBox{}, "box/MsgSaleBox", nil)
 	cdc.RegisterConcrete(MsgBoxInterest{}, "box/MsgBoxInterest", nil)
 	cdc.RegisterConcrete(MsgBoxDeposit{}, "box/MsgBoxDeposit", nil)
 	cdc.RegisterConcrete(MsgBoxDescription{}, "box/MsgBoxDescription", nil)
 	cdc.RegisterConcrete(MsgBoxDisableFeature{}, "box/MsgBoxDisableFeature", nil)
+	cdc.RegisterConcrete(MsgBoxClaim{}, "box/MsgBoxClaim", nil)
+	cdc.RegisterConcrete(MsgBoxRevoke{}, "box/MsgBoxRevoke", nil)
+	cdc.RegisterConcrete(MsgBoxRelease{}, "box/MsgBoxRelease", nil)
+	cdc.RegisterConcrete(MsgBoxRefund{}, "box/MsgBoxRefund", nil)
+	cdc.RegisterConcrete(MsgBoxWithdraw{}, "box/MsgBoxWithdraw", nil)
+	cdc.RegisterConcrete(MsgBoxRetry{}, "box/MsgBoxRetry", nil)
 
 	cdc.RegisterInterface((*types.Box)(nil), nil)
 	cdc.RegisterConcrete(&types.BoxInfo{}, "box/BoxInfo", nil)
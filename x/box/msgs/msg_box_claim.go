@@ -0,0 +1,54 @@
+package msgs
+
+import (
+	"fmt"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgBoxClaim
+type MsgBoxClaim struct {
+	BoxId  string         `json:"box_id"`
+	Sender sdk.AccAddress `json:"sender"`
+}
+
+//New MsgBoxClaim Instance
+func NewMsgBoxClaim(boxId string, sender sdk.AccAddress) MsgBoxClaim {
+	return MsgBoxClaim{boxId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgBoxClaim) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBoxClaim) Type() string { return types.TypeMsgBoxClaim }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgBoxClaim) ValidateBasic() sdk.Error {
+	if len(msg.BoxId) == 0 {
+		return errors.ErrUnknownBox("")
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBoxClaim) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBoxClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBoxClaim) String() string {
+	return fmt.Sprintf("MsgBoxClaim{%s}", msg.BoxId)
+}
@@ -0,0 +1,54 @@
+package msgs
+
+import (
+	"fmt"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgBoxRefund
+type MsgBoxRefund struct {
+	BoxId  string         `json:"box_id"`
+	Sender sdk.AccAddress `json:"sender"`
+}
+
+//New MsgBoxRefund Instance
+func NewMsgBoxRefund(boxId string, sender sdk.AccAddress) MsgBoxRefund {
+	return MsgBoxRefund{boxId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgBoxRefund) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBoxRefund) Type() string { return types.TypeMsgBoxRefund }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgBoxRefund) ValidateBasic() sdk.Error {
+	if len(msg.BoxId) == 0 {
+		return errors.ErrUnknownBox("")
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBoxRefund) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBoxRefund) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBoxRefund) String() string {
+	return fmt.Sprintf("MsgBoxRefund{%s}", msg.BoxId)
+}
@@ -0,0 +1,54 @@
+package msgs
+
+import (
+	"fmt"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgBoxRelease
+type MsgBoxRelease struct {
+	BoxId  string         `json:"box_id"`
+	Sender sdk.AccAddress `json:"sender"`
+}
+
+//New MsgBoxRelease Instance
+func NewMsgBoxRelease(boxId string, sender sdk.AccAddress) MsgBoxRelease {
+	return MsgBoxRelease{boxId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgBoxRelease) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBoxRelease) Type() string { return types.TypeMsgBoxRelease }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgBoxRelease) ValidateBasic() sdk.Error {
+	if len(msg.BoxId) == 0 {
+		return errors.ErrUnknownBox("")
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBoxRelease) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBoxRelease) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBoxRelease) String() string {
+	return fmt.Sprintf("MsgBoxRelease{%s}", msg.BoxId)
+}
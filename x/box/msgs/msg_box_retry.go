@@ -0,0 +1,54 @@
+package msgs
+
+import (
+	"fmt"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgBoxRetry
+type MsgBoxRetry struct {
+	BoxId  string         `json:"box_id"`
+	Sender sdk.AccAddress `json:"sender"`
+}
+
+//New MsgBoxRetry Instance
+func NewMsgBoxRetry(boxId string, sender sdk.AccAddress) MsgBoxRetry {
+	return MsgBoxRetry{boxId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgBoxRetry) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBoxRetry) Type() string { return types.TypeMsgBoxRetry }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgBoxRetry) ValidateBasic() sdk.Error {
+	if len(msg.BoxId) == 0 {
+		return errors.ErrUnknownBox("")
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBoxRetry) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBoxRetry) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBoxRetry) String() string {
+	return fmt.Sprintf("MsgBoxRetry{%s}", msg.BoxId)
+}
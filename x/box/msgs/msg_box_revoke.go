@@ -0,0 +1,54 @@
+package msgs
+
+import (
+	"fmt"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgBoxRevoke
+type MsgBoxRevoke struct {
+	BoxId  string         `json:"box_id"`
+	Sender sdk.AccAddress `json:"sender"`
+}
+
+//New MsgBoxRevoke Instance
+func NewMsgBoxRevoke(boxId string, sender sdk.AccAddress) MsgBoxRevoke {
+	return MsgBoxRevoke{boxId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgBoxRevoke) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBoxRevoke) Type() string { return types.TypeMsgBoxRevoke }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgBoxRevoke) ValidateBasic() sdk.Error {
+	if len(msg.BoxId) == 0 {
+		return errors.ErrUnknownBox("")
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBoxRevoke) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBoxRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBoxRevoke) String() string {
+	return fmt.Sprintf("MsgBoxRevoke{%s}", msg.BoxId)
+}
@@ -0,0 +1,58 @@
+package msgs
+
+import (
+	"fmt"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgBoxWithdraw
+type MsgBoxWithdraw struct {
+	BoxId  string         `json:"box_id"`
+	Sender sdk.AccAddress `json:"sender"`
+	Amount sdk.Coin       `json:"amount"`
+}
+
+//New MsgBoxWithdraw Instance
+func NewMsgBoxWithdraw(boxId string, sender sdk.AccAddress, amount sdk.Coin) MsgBoxWithdraw {
+	return MsgBoxWithdraw{boxId, sender, amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBoxWithdraw) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBoxWithdraw) Type() string { return types.TypeMsgBoxWithdraw }
+
+// Implements Msg. Ensures addresses are valid and Coin is positive
+func (msg MsgBoxWithdraw) ValidateBasic() sdk.Error {
+	if len(msg.BoxId) == 0 {
+		return errors.ErrUnknownBox("")
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	if msg.Amount.IsZero() || msg.Amount.IsNegative() {
+		return errors.ErrAmountNotValid(msg.Amount.Denom)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBoxWithdraw) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBoxWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBoxWithdraw) String() string {
+	return fmt.Sprintf("MsgBoxWithdraw{%s}", msg.BoxId)
+}
@@ -79,6 +79,10 @@ func (msg MsgDepositBox) validateBox() sdk.Error {
 		msg.Deposit.Interest.Token.Amount, msg.Deposit.Interest.Decimals)) {
 		return errors.ErrAmountNotValid("PerCoupon")
 	}
+	if !msg.Deposit.PenaltyRate.IsNil() && (msg.Deposit.PenaltyRate.LT(sdk.ZeroDec()) ||
+		msg.Deposit.PenaltyRate.GTE(sdk.OneDec()) || (!msg.Deposit.EarlyWithdraw && !msg.Deposit.PenaltyRate.IsZero())) {
+		return errors.ErrAmountNotValid("PenaltyRate")
+	}
 
 	return nil
 }
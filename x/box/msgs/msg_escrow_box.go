@@ -0,0 +1,83 @@
+package msgs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashgard/hashgard/x/box/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgEscrowBox to allow a registered boxr
+// to escrow coins for a beneficiary.
+type MsgEscrowBox struct {
+	*params.BoxEscrowParams
+}
+
+func NewMsgEscrowBox(params *params.BoxEscrowParams) MsgEscrowBox {
+	return MsgEscrowBox{params}
+}
+
+// Route Implements Msg.
+func (msg MsgEscrowBox) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgEscrowBox) Type() string { return types.TypeMsgBox }
+
+// Implements Msg. Ensures addresses are valid and Coin is positive
+func (msg MsgEscrowBox) ValidateBasic() sdk.Error {
+	if types.Escrow != msg.BoxType {
+		return errors.ErrUnknownBoxType()
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	if msg.TotalAmount.Token.IsZero() || msg.TotalAmount.Token.Amount.IsNegative() {
+		return errors.ErrAmountNotValid("Token amount")
+	}
+	if len(msg.Name) > types.BoxNameMaxLength {
+		return errors.ErrBoxNameNotValid()
+	}
+	if len(msg.Description) > types.BoxDescriptionMaxLength {
+		return errors.ErrBoxDescriptionMaxLengthNotValid()
+	}
+	if err := msg.validateBox(); err != nil {
+		return err
+	}
+	return nil
+}
+func (msg MsgEscrowBox) validateBox() sdk.Error {
+	if len(msg.Escrow.Beneficiary) == 0 {
+		return sdk.ErrInvalidAddress("Beneficiary address cannot be empty")
+	}
+	if len(msg.Escrow.Arbiter) == 0 {
+		return sdk.ErrInvalidAddress("Arbiter address cannot be empty")
+	}
+	if msg.Escrow.Beneficiary.Equals(msg.Sender) || msg.Escrow.Arbiter.Equals(msg.Sender) ||
+		msg.Escrow.Arbiter.Equals(msg.Escrow.Beneficiary) {
+		return sdk.ErrInvalidAddress("Sender, beneficiary and arbiter must be different addresses")
+	}
+	if msg.Escrow.ExpireTime < time.Now().Unix() {
+		return errors.ErrTimeNotValid("ExpireTime")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgEscrowBox) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgEscrowBox) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgEscrowBox) String() string {
+	return fmt.Sprintf("MsgEscrowBox{%s - %s}", msg.Escrow.Beneficiary.String(), msg.Sender.String())
+}
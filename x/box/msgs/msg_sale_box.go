@@ -0,0 +1,89 @@
+package msgs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashgard/hashgard/x/box/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgSaleBox to allow a registered boxr
+// to sell coins for a price.
+type MsgSaleBox struct {
+	*params.BoxSaleParams
+}
+
+func NewMsgSaleBox(params *params.BoxSaleParams) MsgSaleBox {
+	return MsgSaleBox{params}
+}
+
+// Route Implements Msg.
+func (msg MsgSaleBox) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSaleBox) Type() string { return types.TypeMsgBox }
+
+// Implements Msg. Ensures addresses are valid and Coin is positive
+func (msg MsgSaleBox) ValidateBasic() sdk.Error {
+	if types.Sale != msg.BoxType {
+		return errors.ErrUnknownBoxType()
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	if msg.TotalAmount.Token.IsZero() || msg.TotalAmount.Token.Amount.IsNegative() {
+		return errors.ErrAmountNotValid("Token amount")
+	}
+	if len(msg.Name) > types.BoxNameMaxLength {
+		return errors.ErrBoxNameNotValid()
+	}
+	if len(msg.Description) > types.BoxDescriptionMaxLength {
+		return errors.ErrBoxDescriptionMaxLengthNotValid()
+	}
+	if err := msg.validateBox(); err != nil {
+		return err
+	}
+	return nil
+}
+func (msg MsgSaleBox) validateBox() sdk.Error {
+	if msg.Sale.Price.Amount.IsNil() || !msg.Sale.Price.Amount.IsPositive() || !(sdk.Coins{msg.Sale.Price}).IsValid() ||
+		msg.Sale.Price.Denom == msg.TotalAmount.Token.Denom {
+		return errors.ErrAmountNotValid("Price")
+	}
+	if msg.Sale.StartTime < time.Now().Unix() {
+		return errors.ErrTimeNotValid("StartTime")
+	}
+	if msg.Sale.EndTime <= msg.Sale.StartTime {
+		return errors.ErrTimeNotValid("EndTime")
+	}
+	if msg.Sale.SoftCap.IsNil() || !msg.Sale.SoftCap.IsPositive() {
+		return errors.ErrAmountNotValid("SoftCap")
+	}
+	if msg.Sale.HardCap.IsNil() || msg.Sale.HardCap.LT(msg.Sale.SoftCap) {
+		return errors.ErrAmountNotValid("HardCap")
+	}
+	if msg.Sale.GetTokenAmount(msg.Sale.HardCap, msg.TotalAmount.Decimals).GT(msg.TotalAmount.Token.Amount) {
+		return errors.ErrAmountNotValid("Token amount")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSaleBox) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSaleBox) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgSaleBox) String() string {
+	return fmt.Sprintf("MsgSaleBox{%s - %s}", msg.Sale.Price.String(), msg.Sender.String())
+}
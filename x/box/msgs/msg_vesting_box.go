@@ -0,0 +1,91 @@
+package msgs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashgard/hashgard/x/box/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/box/errors"
+	"github.com/hashgard/hashgard/x/box/types"
+)
+
+// MsgVestingBox to allow a registered boxr
+// to vest coins to a beneficiary.
+type MsgVestingBox struct {
+	*params.BoxVestingParams
+}
+
+func NewMsgVestingBox(params *params.BoxVestingParams) MsgVestingBox {
+	return MsgVestingBox{params}
+}
+
+// Route Implements Msg.
+func (msg MsgVestingBox) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgVestingBox) Type() string { return types.TypeMsgBox }
+
+// Implements Msg. Ensures addresses are valid and Coin is positive
+func (msg MsgVestingBox) ValidateBasic() sdk.Error {
+	if types.Vesting != msg.BoxType {
+		return errors.ErrUnknownBoxType()
+	}
+	if len(msg.Sender) == 0 {
+		return sdk.ErrInvalidAddress("Sender address cannot be empty")
+	}
+	if msg.TotalAmount.Token.IsZero() || msg.TotalAmount.Token.Amount.IsNegative() {
+		return errors.ErrAmountNotValid("Token amount")
+	}
+	if len(msg.Name) > types.BoxNameMaxLength {
+		return errors.ErrBoxNameNotValid()
+	}
+	if len(msg.Description) > types.BoxDescriptionMaxLength {
+		return errors.ErrBoxDescriptionMaxLengthNotValid()
+	}
+	if err := msg.validateBox(); err != nil {
+		return err
+	}
+	return nil
+}
+func (msg MsgVestingBox) validateBox() sdk.Error {
+	if len(msg.Vesting.Beneficiary) == 0 {
+		return sdk.ErrInvalidAddress("Beneficiary address cannot be empty")
+	}
+	if msg.Vesting.StartTime <= 0 {
+		return errors.ErrTimeNotValid("StartTime")
+	}
+	if msg.Vesting.EndTime <= msg.Vesting.StartTime || msg.Vesting.EndTime < time.Now().Unix() {
+		return errors.ErrTimeNotValid("EndTime")
+	}
+	if msg.Vesting.CliffTime != 0 && (msg.Vesting.CliffTime < msg.Vesting.StartTime || msg.Vesting.CliffTime > msg.Vesting.EndTime) {
+		return errors.ErrTimeNotValid("CliffTime")
+	}
+	if msg.Vesting.Period < 0 || msg.Vesting.Period > msg.Vesting.EndTime-msg.Vesting.StartTime {
+		return errors.ErrTimeNotValid("Period")
+	}
+	if msg.Vesting.RevokeTime != 0 {
+		return errors.ErrTimeNotValid("RevokeTime")
+	}
+	if !msg.Vesting.Claimed.IsNil() && !msg.Vesting.Claimed.IsZero() {
+		return errors.ErrAmountNotValid("Claimed")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgVestingBox) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgVestingBox) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgVestingBox) String() string {
+	return fmt.Sprintf("MsgVestingBox{%s - %s}", msg.Vesting.Beneficiary.String(), msg.Sender.String())
+}
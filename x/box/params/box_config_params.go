@@ -8,17 +8,33 @@ import (
 
 // Param Config issue for issue
 type BoxConfigParams struct {
-	MinDeposit    sdk.Coins `json:"min_deposit"`
-	StartingBoxId uint64    `json:"starting_box_id"`
+	MinDeposit       sdk.Coins      `json:"min_deposit"`
+	StartingBoxId    uint64         `json:"starting_box_id"`
+	MaxBoxesPerBlock uint64         `json:"max_boxes_per_block"`
+	RetryAuthority   sdk.AccAddress `json:"retry_authority"`
 }
 
 func (dp BoxConfigParams) String() string {
-	return fmt.Sprintf(`Box Params:Min Deposit:%s`, dp.MinDeposit)
+	return fmt.Sprintf(`Box Params:Min Deposit:%s,Max Boxes Per Block:%d,Retry Authority:%s`,
+		dp.MinDeposit, dp.MaxBoxesPerBlock, dp.RetryAuthority)
 }
 
 // Checks equality of BoxConfigParams
 func (dp BoxConfigParams) Equal(dp2 BoxConfigParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit)
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) &&
+		dp.MaxBoxesPerBlock == dp2.MaxBoxesPerBlock &&
+		dp.RetryAuthority.Equals(dp2.RetryAuthority)
+}
+
+// Returns an error if the end blocker would not process any box
+func (dp BoxConfigParams) Validate() error {
+	if !dp.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid box param min_deposit: %s", dp.MinDeposit)
+	}
+	if dp.MaxBoxesPerBlock == 0 {
+		return fmt.Errorf("box param max_boxes_per_block must be positive")
+	}
+	return nil
 }
 
 // Params returns all of the issue params
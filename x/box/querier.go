@@ -19,6 +19,14 @@ func NewQuerier(keeper keeper.Keeper) sdk.Querier {
 			return queriers.QueryName(ctx, path[1], path[2], keeper)
 		case types.QueryDepositAmount:
 			return queriers.QueryDepositAmountFromDepositBox(ctx, path[1], path[2], keeper)
+		case types.QueryClaimable:
+			return queriers.QueryClaimable(ctx, path[1], keeper)
+		case types.QueryUnclaimed:
+			return queriers.QueryUnclaimed(ctx, req, keeper)
+		case types.QueryFailed:
+			return queriers.QueryFailed(ctx, keeper)
+		case types.QueryEscrow:
+			return queriers.QueryEscrow(ctx, path[1], keeper)
 		case types.QueryList:
 			return queriers.QueryList(ctx, req, keeper)
 		case types.QueryDepositList:
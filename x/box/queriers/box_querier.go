@@ -48,6 +48,31 @@ func QueryDepositAmountFromDepositBox(ctx sdk.Context, boxID string, accAddress
 	}
 	return bz, nil
 }
+func QueryClaimable(ctx sdk.Context, boxID string, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	claimable, sdkErr := keeper.GetVestingBoxClaimable(ctx, boxID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.Getcdc(), claimable)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+func QueryEscrow(ctx sdk.Context, accAddress string, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	address, err := sdk.AccAddressFromBech32(accAddress)
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(accAddress)
+	}
+	boxs := keeper.GetEscrowBoxesByParty(ctx, address)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Getcdc(), boxs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
 func QueryList(ctx sdk.Context, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
 	var params params.BoxQueryParams
 	err := keeper.Getcdc().UnmarshalJSON(req.Data, &params)
@@ -76,3 +101,29 @@ func QueryDepositList(ctx sdk.Context, req abci.RequestQuery, keeper keeper.Keep
 	}
 	return bz, nil
 }
+func QueryUnclaimed(ctx sdk.Context, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params params.BoxQueryDepositListParams
+	err := keeper.Getcdc().UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	list, sdkErr := keeper.QueryUnclaimedFromDepositBox(ctx, params.BoxId, params.Owner)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	bz, err := codec.MarshalJSONIndent(keeper.Getcdc(), list)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+func QueryFailed(ctx sdk.Context, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	failures := keeper.GetAllFailedBoxes(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Getcdc(), failures)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
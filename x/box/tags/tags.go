@@ -18,4 +18,6 @@ var (
 	BoxType   = "box-type"
 	BoxStatus = "box-status"
 	Seq       = "seq"
+	Amount    = "amount"
+	Reason    = "reason"
 )
@@ -13,6 +13,7 @@ import (
 	"github.com/hashgard/hashgard/x/box/msgs"
 	"github.com/hashgard/hashgard/x/box/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -120,6 +121,21 @@ func TestDepositBoxEndBlocker(t *testing.T) {
 	require.False(t, inactiveQueue.Valid())
 	inactiveQueue.Close()
 
+	depositBox = keeper.GetBox(ctx, boxInfo.BoxId)
+	require.Equal(t, depositBox.BoxStatus, types.DepositBoxMatured)
+	coins = keeper.GetBankKeeper().GetCoins(ctx, TransferAccAddr)
+	require.Equal(t, coins.AmountOf(boxInfo.BoxId), depositTo.Quo(depositBox.Deposit.Price))
+
+	unclaimed, err := keeper.QueryUnclaimedFromDepositBox(ctx, boxInfo.BoxId, nil)
+	require.Nil(t, err)
+	require.Len(t, unclaimed, 1)
+	require.Equal(t, depositTo, unclaimed[0].Amount)
+
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxInfo.BoxId, SenderAccAddr))
+	require.False(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxInfo.BoxId, TransferAccAddr))
+	require.True(t, res.IsOK())
+
 	depositBox = keeper.GetBox(ctx, boxInfo.BoxId)
 	require.Equal(t, depositBox.BoxStatus, types.BoxFinished)
 	coins = keeper.GetBankKeeper().GetCoins(ctx, TransferAccAddr)
@@ -257,3 +273,296 @@ func TestDepositBoxNotEnoughDepositEndBlocker(t *testing.T) {
 	coins = keeper.GetBankKeeper().GetCoins(ctx, TransferAccAddr)
 	require.Equal(t, coins.AmountOf(boxInfo.TotalAmount.Token.Denom), boxInfo.Deposit.BottomLine)
 }
+
+func TestDepositBoxEarlyWithdrawEndBlocker(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetDepositBoxInfo()
+	boxParams.Deposit.EarlyWithdraw = true
+	boxParams.Deposit.PenaltyRate = sdk.NewDecWithPrec(1, 1)
+	res := handler(ctx, msgs.NewMsgDepositBox(boxParams))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+	boxInfo := keeper.GetBox(ctx, boxID)
+
+	keeper.GetBankKeeper().AddCoins(ctx, boxInfo.Owner, sdk.NewCoins(boxInfo.Deposit.Interest.Token))
+	res = handler(ctx, msgs.NewMsgBoxInterest(boxID, boxInfo.Owner, boxInfo.Deposit.Interest.Token, types.Injection))
+	require.True(t, res.IsOK())
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.StartTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	denom := boxInfo.TotalAmount.Token.Denom
+	depositor1 := TransferAccAddr
+	depositor2 := sdk.AccAddress(crypto.AddressHash([]byte("depositorAddress")))
+	deposit := boxInfo.Deposit.Price.MulRaw(10)
+	for _, depositor := range []sdk.AccAddress{depositor1, depositor2} {
+		keeper.GetBankKeeper().AddCoins(ctx, depositor, sdk.NewCoins(sdk.NewCoin(denom, deposit)))
+		res = handler(ctx, msgs.NewMsgBoxDeposit(boxID, depositor, sdk.NewCoin(denom, deposit), types.DepositTo))
+		require.True(t, res.IsOK())
+	}
+
+	// early withdrawal is only allowed once the box pays interest
+	withdraw := sdk.NewCoin(denom, boxInfo.Deposit.Price.MulRaw(5))
+	res = handler(ctx, msgs.NewMsgBoxWithdraw(boxID, depositor1, withdraw))
+	require.False(t, res.IsOK())
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.EstablishTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	depositBox := keeper.GetBox(ctx, boxID)
+	require.Equal(t, types.DepositBoxInterest, depositBox.BoxStatus)
+	interest := depositBox.Deposit.Interest.Token
+
+	res = handler(ctx, msgs.NewMsgBoxWithdraw(boxID, depositor1, sdk.NewCoin(denom, boxInfo.Deposit.Price.AddRaw(1))))
+	require.False(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxWithdraw(boxID, depositor1, withdraw))
+	require.True(t, res.IsOK())
+
+	penalty := withdraw.Amount.QuoRaw(10)
+	coins := keeper.GetBankKeeper().GetCoins(ctx, depositor1)
+	require.Equal(t, withdraw.Amount.Sub(penalty), coins.AmountOf(denom))
+	require.Equal(t, sdk.NewInt(5), coins.AmountOf(boxID))
+
+	depositBox = keeper.GetBox(ctx, boxID)
+	require.Equal(t, sdk.NewInt(15), depositBox.Deposit.Share)
+	require.Equal(t, penalty, depositBox.Deposit.Penalty)
+	require.Equal(t, utils.CalcInterestRate(depositBox.Deposit.TotalDeposit, depositBox.Deposit.Price,
+		interest.Amount, depositBox.Deposit.Interest.Decimals), depositBox.Deposit.PerCoupon)
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.MaturityTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	require.Equal(t, types.DepositBoxMatured, keeper.GetBox(ctx, boxID).BoxStatus)
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, depositor1))
+	require.True(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, depositor1))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.DepositBoxMatured, keeper.GetBox(ctx, boxID).BoxStatus)
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, depositor2))
+	require.True(t, res.IsOK())
+
+	require.Equal(t, types.BoxFinished, keeper.GetBox(ctx, boxID).BoxStatus)
+	require.True(t, keeper.GetDepositedCoins(ctx, boxID).IsZero())
+
+	// the forfeited interest and the penalty went to the remaining depositors
+	coins1 := keeper.GetBankKeeper().GetCoins(ctx, depositor1)
+	coins2 := keeper.GetBankKeeper().GetCoins(ctx, depositor2)
+	require.Equal(t, interest.Amount, coins1.AmountOf(interest.Denom).Add(coins2.AmountOf(interest.Denom)))
+	require.Equal(t, deposit.MulRaw(2), coins1.AmountOf(denom).Add(coins2.AmountOf(denom)))
+	require.True(t, coins1.AmountOf(denom).LT(deposit))
+	require.True(t, coins2.AmountOf(denom).GT(deposit))
+}
+
+func TestDepositBoxWithdrawTransferredCertificates(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetDepositBoxInfo()
+	boxParams.Deposit.EarlyWithdraw = true
+	boxParams.Deposit.PenaltyRate = sdk.NewDecWithPrec(1, 1)
+	res := handler(ctx, msgs.NewMsgDepositBox(boxParams))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+	boxInfo := keeper.GetBox(ctx, boxID)
+
+	keeper.GetBankKeeper().AddCoins(ctx, boxInfo.Owner, sdk.NewCoins(boxInfo.Deposit.Interest.Token))
+	res = handler(ctx, msgs.NewMsgBoxInterest(boxID, boxInfo.Owner, boxInfo.Deposit.Interest.Token, types.Injection))
+	require.True(t, res.IsOK())
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.StartTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	denom := boxInfo.TotalAmount.Token.Denom
+	depositor := TransferAccAddr
+	buyer := sdk.AccAddress(crypto.AddressHash([]byte("buyerAddress")))
+	deposit := boxInfo.Deposit.Price.MulRaw(10)
+	keeper.GetBankKeeper().AddCoins(ctx, depositor, sdk.NewCoins(sdk.NewCoin(denom, deposit)))
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxID, depositor, sdk.NewCoin(denom, deposit), types.DepositTo))
+	require.True(t, res.IsOK())
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.EstablishTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+	require.Equal(t, types.DepositBoxInterest, keeper.GetBox(ctx, boxID).BoxStatus)
+
+	// the buyer of a certificate withdraws it, the depositor only what is left
+	share := deposit.Quo(boxInfo.Deposit.Price)
+	sold := sdk.NewInt(4)
+	err := keeper.GetBankKeeper().SendCoins(ctx, depositor, buyer, sdk.NewCoins(sdk.NewCoin(boxID, sold)))
+	require.Nil(t, err)
+
+	res = handler(ctx, msgs.NewMsgBoxWithdraw(boxID, depositor, sdk.NewCoin(denom, deposit)))
+	require.False(t, res.IsOK())
+	withdraw := sdk.NewCoin(denom, boxInfo.Deposit.Price.Mul(sold))
+	res = handler(ctx, msgs.NewMsgBoxWithdraw(boxID, buyer, withdraw))
+	require.True(t, res.IsOK())
+
+	coins := keeper.GetBankKeeper().GetCoins(ctx, buyer)
+	require.Equal(t, withdraw.Amount.Sub(withdraw.Amount.QuoRaw(10)), coins.AmountOf(denom))
+	require.Equal(t, sdk.ZeroInt(), coins.AmountOf(boxID))
+	require.Equal(t, share.Sub(sold), keeper.GetBox(ctx, boxID).Deposit.Share)
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+
+	res = handler(ctx, msgs.NewMsgBoxWithdraw(boxID, depositor, sdk.NewCoin(denom, deposit.Sub(withdraw.Amount))))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.ZeroInt(), keeper.GetBankKeeper().GetCoins(ctx, depositor).AmountOf(boxID))
+	require.Nil(t, keeper.GetBox(ctx, boxID))
+}
+
+func TestDepositBoxAutoClaimEndBlocker(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetDepositBoxInfo()
+	boxParams.Deposit.AutoClaim = true
+	res := handler(ctx, msgs.NewMsgDepositBox(boxParams))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+	boxInfo := keeper.GetBox(ctx, boxID)
+
+	keeper.GetBankKeeper().AddCoins(ctx, boxInfo.Owner, sdk.NewCoins(boxInfo.Deposit.Interest.Token))
+	res = handler(ctx, msgs.NewMsgBoxInterest(boxID, boxInfo.Owner, boxInfo.Deposit.Interest.Token, types.Injection))
+	require.True(t, res.IsOK())
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.StartTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	denom := boxInfo.TotalAmount.Token.Denom
+	deposit := boxInfo.Deposit.BottomLine
+	keeper.GetBankKeeper().AddCoins(ctx, TransferAccAddr, sdk.NewCoins(sdk.NewCoin(denom, deposit)))
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxID, TransferAccAddr, sdk.NewCoin(denom, deposit), types.DepositTo))
+	require.True(t, res.IsOK())
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.EstablishTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.MaturityTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	depositBox := keeper.GetBox(ctx, boxID)
+	require.Equal(t, types.BoxFinished, depositBox.BoxStatus)
+	require.True(t, keeper.GetDepositedCoins(ctx, boxID).IsZero())
+
+	coins := keeper.GetBankKeeper().GetCoins(ctx, TransferAccAddr)
+	require.Equal(t, sdk.ZeroInt(), coins.AmountOf(boxID))
+	require.Equal(t, deposit, coins.AmountOf(denom))
+	require.Equal(t, depositBox.Deposit.Interest.Token.Amount, coins.AmountOf(depositBox.Deposit.Interest.Token.Denom))
+}
+
+func TestDepositBoxClaimTransferredCertificates(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetDepositBoxInfo()
+	boxParams.Deposit.AutoClaim = true
+	res := handler(ctx, msgs.NewMsgDepositBox(boxParams))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+	boxInfo := keeper.GetBox(ctx, boxID)
+
+	keeper.GetBankKeeper().AddCoins(ctx, boxInfo.Owner, sdk.NewCoins(boxInfo.Deposit.Interest.Token))
+	res = handler(ctx, msgs.NewMsgBoxInterest(boxID, boxInfo.Owner, boxInfo.Deposit.Interest.Token, types.Injection))
+	require.True(t, res.IsOK())
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.StartTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	denom := boxInfo.TotalAmount.Token.Denom
+	depositor := TransferAccAddr
+	buyer := sdk.AccAddress(crypto.AddressHash([]byte("buyerAddress")))
+	deposit := boxInfo.Deposit.Price.MulRaw(10)
+	keeper.GetBankKeeper().AddCoins(ctx, depositor, sdk.NewCoins(sdk.NewCoin(denom, deposit)))
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxID, depositor, sdk.NewCoin(denom, deposit), types.DepositTo))
+	require.True(t, res.IsOK())
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.EstablishTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	sold := sdk.NewInt(4)
+	err := keeper.GetBankKeeper().SendCoins(ctx, depositor, buyer, sdk.NewCoins(sdk.NewCoin(boxID, sold)))
+	require.Nil(t, err)
+
+	// auto claim pays the certificates left with the depositor, the buyer claims by itself
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxInfo.Deposit.MaturityTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+	box.EndBlocker(ctx, keeper)
+
+	depositBox := keeper.GetBox(ctx, boxID)
+	require.Equal(t, types.DepositBoxMatured, depositBox.BoxStatus)
+	coins := keeper.GetBankKeeper().GetCoins(ctx, depositor)
+	require.Equal(t, sdk.ZeroInt(), coins.AmountOf(boxID))
+	require.Equal(t, deposit.Sub(boxInfo.Deposit.Price.Mul(sold)), coins.AmountOf(denom))
+
+	unclaimed, err := keeper.QueryUnclaimedFromDepositBox(ctx, boxID, buyer)
+	require.Nil(t, err)
+	require.Len(t, unclaimed, 1)
+	require.Equal(t, boxInfo.Deposit.Price.Mul(sold), unclaimed[0].Amount)
+
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, depositor))
+	require.False(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, buyer))
+	require.True(t, res.IsOK())
+
+	depositBox = keeper.GetBox(ctx, boxID)
+	require.Equal(t, types.BoxFinished, depositBox.BoxStatus)
+	require.True(t, keeper.GetDepositedCoins(ctx, boxID).IsZero())
+
+	interestDenom := depositBox.Deposit.Interest.Token.Denom
+	coins = keeper.GetBankKeeper().GetCoins(ctx, buyer)
+	require.Equal(t, boxInfo.Deposit.Price.Mul(sold), coins.AmountOf(denom))
+	require.Equal(t, depositBox.Deposit.Interest.Token.Amount,
+		coins.AmountOf(interestDenom).Add(keeper.GetBankKeeper().GetCoins(ctx, depositor).AmountOf(interestDenom)))
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestEndBlockerFailedBoxAndCarryOver(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	boxConfigParams := keeper.GetBoxConfigParams(ctx)
+	boxConfigParams.MaxBoxesPerBlock = 1
+	keeper.SetBoxConfigParams(ctx, boxConfigParams)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetLockBoxInfo()
+	boxIDs := make([]string, 2)
+	for i := range boxIDs {
+		keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, sdk.NewCoins(boxParams.TotalAmount.Token))
+		res := handler(ctx, msgs.NewMsgLockBox(boxParams))
+		require.True(t, res.IsOK())
+		keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxIDs[i])
+	}
+
+	// the first box can not be unlocked once its owner has no certificate coins
+	certificate := sdk.NewCoins(sdk.NewCoin(boxIDs[0], boxParams.TotalAmount.Token.Amount))
+	_, err := keeper.GetBankKeeper().SubtractCoins(ctx, boxParams.Sender, certificate)
+	require.Nil(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Lock.EndTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	resTags := box.EndBlocker(ctx, keeper)
+	require.Equal(t, types.BoxFailed, string(resTags.ToKVPairs()[1].Value))
+	require.Len(t, keeper.GetFailedBoxes(ctx, boxIDs[0]), 1)
+	require.Equal(t, types.LockBoxLocked, keeper.GetBox(ctx, boxIDs[0]).BoxStatus)
+
+	// the second box carries over to the next block
+	require.Equal(t, types.LockBoxLocked, keeper.GetBox(ctx, boxIDs[1]).BoxStatus)
+	box.EndBlocker(ctx, keeper)
+	require.Equal(t, types.LockBoxUnlocked, keeper.GetBox(ctx, boxIDs[1]).BoxStatus)
+
+	inactiveQueue := keeper.ActiveBoxQueueIterator(ctx, ctx.BlockHeader().Time.Unix())
+	require.False(t, inactiveQueue.Valid())
+	inactiveQueue.Close()
+
+	res := handler(ctx, msgs.NewMsgBoxRetry(boxIDs[0], TransferAccAddr))
+	require.False(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxRetry(boxIDs[1], boxParams.Sender))
+	require.False(t, res.IsOK())
+
+	keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, certificate)
+	res = handler(ctx, msgs.NewMsgBoxRetry(boxIDs[0], boxParams.Sender))
+	require.True(t, res.IsOK())
+	require.Len(t, keeper.GetAllFailedBoxes(ctx), 0)
+	require.Equal(t, types.LockBoxUnlocked, keeper.GetBox(ctx, boxIDs[0]).BoxStatus)
+
+	coins := keeper.GetBankKeeper().GetCoins(ctx, boxParams.Sender)
+	require.Equal(t, boxParams.TotalAmount.Token.Amount.MulRaw(2), coins.AmountOf(boxParams.TotalAmount.Token.Denom))
+}
+
+func TestRetryFailedBoxByAuthority(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetLockBoxInfo()
+	keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, sdk.NewCoins(boxParams.TotalAmount.Token))
+	res := handler(ctx, msgs.NewMsgLockBox(boxParams))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+
+	certificate := sdk.NewCoins(sdk.NewCoin(boxID, boxParams.TotalAmount.Token.Amount))
+	_, err := keeper.GetBankKeeper().SubtractCoins(ctx, boxParams.Sender, certificate)
+	require.Nil(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Lock.EndTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+	require.Len(t, keeper.GetFailedBoxes(ctx, boxID), 1)
+	keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, certificate)
+
+	res = handler(ctx, msgs.NewMsgBoxRetry(boxID, TransferAccAddr))
+	require.False(t, res.IsOK())
+
+	boxConfigParams := keeper.GetBoxConfigParams(ctx)
+	boxConfigParams.RetryAuthority = TransferAccAddr
+	keeper.SetBoxConfigParams(ctx, boxConfigParams)
+
+	res = handler(ctx, msgs.NewMsgBoxRetry(boxID, TransferAccAddr))
+	require.True(t, res.IsOK())
+	require.Len(t, keeper.GetAllFailedBoxes(ctx), 0)
+	require.Equal(t, types.LockBoxUnlocked, keeper.GetBox(ctx, boxID).BoxStatus)
+}
+
+func TestEndBlockerWithoutMaxBoxesPerBlock(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	// params stored before the upgrade have no limit
+	boxConfigParams := keeper.GetBoxConfigParams(ctx)
+	boxConfigParams.MaxBoxesPerBlock = 0
+	keeper.SetBoxConfigParams(ctx, boxConfigParams)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetLockBoxInfo()
+	keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, sdk.NewCoins(boxParams.TotalAmount.Token))
+	res := handler(ctx, msgs.NewMsgLockBox(boxParams))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Lock.EndTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	box.EndBlocker(ctx, keeper)
+	require.Equal(t, types.LockBoxUnlocked, keeper.GetBox(ctx, boxID).BoxStatus)
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestEscrowBoxReleaseAndExpire(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetEscrowBoxInfo()
+	total := boxParams.TotalAmount.Token
+	owner := boxParams.Sender
+	beneficiary := boxParams.Escrow.Beneficiary
+	arbiter := boxParams.Escrow.Arbiter
+	keeper.GetBankKeeper().AddCoins(ctx, owner, sdk.NewCoins(sdk.NewCoin(total.Denom, total.Amount.MulRaw(4))))
+
+	var boxIDs [4]string
+	for i := range boxIDs {
+		res := handler(ctx, msgs.NewMsgEscrowBox(boxParams))
+		require.True(t, res.IsOK())
+		keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxIDs[i])
+		require.True(t, keeper.GetDepositedCoins(ctx, boxIDs[i]).IsEqual(sdk.NewCoins(total)))
+	}
+	require.Len(t, keeper.GetEscrowBoxesByParty(ctx, arbiter), 4)
+
+	// only the arbiter and the beneficiary release, only the arbiter refunds
+	require.False(t, handler(ctx, msgs.NewMsgBoxRelease(boxIDs[0], owner)).IsOK())
+	require.False(t, handler(ctx, msgs.NewMsgBoxRefund(boxIDs[0], owner)).IsOK())
+	require.False(t, handler(ctx, msgs.NewMsgBoxRefund(boxIDs[0], beneficiary)).IsOK())
+
+	// released by the arbiter
+	require.True(t, handler(ctx, msgs.NewMsgBoxRelease(boxIDs[0], arbiter)).IsOK())
+	require.Equal(t, total.Amount, keeper.GetBankKeeper().GetCoins(ctx, beneficiary).AmountOf(total.Denom))
+	require.Equal(t, types.BoxFinished, keeper.GetBox(ctx, boxIDs[0]).BoxStatus)
+	require.False(t, handler(ctx, msgs.NewMsgBoxRefund(boxIDs[0], arbiter)).IsOK())
+
+	// released by the confirmation of the beneficiary
+	require.True(t, handler(ctx, msgs.NewMsgBoxRelease(boxIDs[1], beneficiary)).IsOK())
+	require.Equal(t, total.Amount.MulRaw(2), keeper.GetBankKeeper().GetCoins(ctx, beneficiary).AmountOf(total.Denom))
+	require.Equal(t, types.BoxFinished, keeper.GetBox(ctx, boxIDs[1]).BoxStatus)
+
+	// refunded by the arbiter
+	require.True(t, handler(ctx, msgs.NewMsgBoxRefund(boxIDs[2], arbiter)).IsOK())
+	require.Equal(t, total.Amount, keeper.GetBankKeeper().GetCoins(ctx, owner).AmountOf(total.Denom))
+	require.Equal(t, types.BoxClosed, keeper.GetBox(ctx, boxIDs[2]).BoxStatus)
+
+	// refunded at the expire time
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Escrow.ExpireTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	box.EndBlocker(ctx, keeper)
+
+	inactiveQueue := keeper.ActiveBoxQueueIterator(ctx, ctx.BlockHeader().Time.Unix())
+	require.False(t, inactiveQueue.Valid())
+	inactiveQueue.Close()
+
+	require.Equal(t, total.Amount.MulRaw(2), keeper.GetBankKeeper().GetCoins(ctx, owner).AmountOf(total.Denom))
+	require.Equal(t, types.BoxClosed, keeper.GetBox(ctx, boxIDs[3]).BoxStatus)
+	for _, boxID := range boxIDs {
+		require.True(t, keeper.GetDepositedCoins(ctx, boxID).IsZero())
+	}
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hashgard/hashgard/x/box"
+	"github.com/hashgard/hashgard/x/box/keeper"
+	"github.com/hashgard/hashgard/x/box/types"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
+)
+
+func TestImportExportGenesis(t *testing.T) {
+	mapp, keeper1, _, _, _, _ := getMockApp(t, 0, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	boxInfo := createFutureBox(t, ctx, keeper1)
+
+	deposit := sdk.NewCoin(boxInfo.TotalAmount.Token.Denom, issueutils.MulDecimals(sdk.NewInt(1000), TestTokenDecimals))
+	keeper1.GetBankKeeper().AddCoins(ctx, TransferAccAddr, sdk.NewCoins(deposit))
+	_, err := keeper1.ProcessDepositToBox(ctx, boxInfo.BoxId, TransferAccAddr, deposit, types.DepositTo)
+	require.Nil(t, err)
+
+	genState := box.ExportGenesis(ctx, keeper1)
+	require.Len(t, genState.Boxes, 1)
+	require.Len(t, genState.Deposits, 1)
+	require.Len(t, genState.ActiveQueue, 1)
+	require.Equal(t, boxInfo.BoxId+":0", genState.ActiveQueue[0].BoxId)
+	require.Equal(t, boxInfo.Future.TimeLine[0], genState.ActiveQueue[0].EndTime)
+	require.Nil(t, box.ValidateGenesis(genState))
+
+	balances := map[string]sdk.Coins{
+		keeper.GetDepositedCoinsAddress(boxInfo.BoxId).String(): keeper1.GetDepositedCoins(ctx, boxInfo.BoxId),
+	}
+	require.Nil(t, box.ValidateGenesisDepositedCoins(genState, balances))
+	require.Error(t, box.ValidateGenesisDepositedCoins(genState, map[string]sdk.Coins{}))
+
+	mapp2, keeper2, _, _, _, _ := getMockApp(t, 0, genState, nil)
+
+	header = abci.Header{Height: mapp2.LastBlockHeight() + 1}
+	mapp2.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx2 := mapp2.BaseApp.NewContext(false, abci.Header{})
+
+	box2 := keeper2.GetBox(ctx2, boxInfo.BoxId)
+	require.NotNil(t, box2)
+	require.Equal(t, boxInfo.Name, box2.Name)
+	require.Len(t, keeper2.GetBoxByAddress(ctx2, types.Future, boxInfo.Owner), 1)
+	require.Len(t, keeper2.SearchBox(ctx2, types.Future, boxInfo.Name), 1)
+	require.True(t, deposit.Amount.Equal(keeper2.GetDepositByAddress(ctx2, boxInfo.BoxId, TransferAccAddr).Amount))
+
+	require.True(t, genState.Equal(box.ExportGenesis(ctx2, keeper2)))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	genState := box.DefaultGenesisState()
+	require.Nil(t, box.ValidateGenesis(genState))
+
+	boxInfo := newBoxInfo
+	boxInfo.BoxId = keeper.KeyBoxIdStr(types.Lock, types.BoxMinId)
+	boxInfo.BoxStatus = types.LockBoxLocked
+	genState.Boxes = []box.BoxInfo{boxInfo}
+	require.Error(t, box.ValidateGenesis(genState))
+
+	genState.StartingLockBoxId = types.BoxMinId + 1
+	require.Nil(t, box.ValidateGenesis(genState))
+
+	genState.Boxes = append(genState.Boxes, boxInfo)
+	require.Error(t, box.ValidateGenesis(genState))
+
+	genState.Boxes = []box.BoxInfo{boxInfo}
+	genState.ActiveQueue = []box.BoxQueueItem{types.NewBoxQueueItem(boxInfo.Lock.EndTime, "boxaa0")}
+	require.Error(t, box.ValidateGenesis(genState))
+
+	genState.ActiveQueue = []box.BoxQueueItem{types.NewBoxQueueItem(boxInfo.Lock.EndTime, boxInfo.BoxId)}
+	require.Nil(t, box.ValidateGenesis(genState))
+
+	balances := map[string]sdk.Coins{
+		keeper.GetDepositedCoinsAddress(boxInfo.BoxId).String(): sdk.NewCoins(boxInfo.TotalAmount.Token),
+	}
+	require.Nil(t, box.ValidateGenesisDepositedCoins(genState, balances))
+
+	// an unsolicited transfer to the box account does not fail the validation
+	balances[keeper.GetDepositedCoinsAddress(boxInfo.BoxId).String()] = sdk.NewCoins(boxInfo.TotalAmount.Token,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	require.Nil(t, box.ValidateGenesisDepositedCoins(genState, balances))
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hashgard/hashgard/x/box"
+	"github.com/hashgard/hashgard/x/box/keeper"
+	"github.com/hashgard/hashgard/x/box/types"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
+)
+
+func TestDepositedCoinsInvariant(t *testing.T) {
+	mapp, keeper1, _, _, _, _ := getMockApp(t, 0, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	boxInfo := createFutureBox(t, ctx, keeper1)
+
+	deposit := sdk.NewCoin(boxInfo.TotalAmount.Token.Denom, issueutils.MulDecimals(sdk.NewInt(1000), TestTokenDecimals))
+	keeper1.GetBankKeeper().AddCoins(ctx, TransferAccAddr, sdk.NewCoins(deposit))
+	_, err := keeper1.ProcessDepositToBox(ctx, boxInfo.BoxId, TransferAccAddr, deposit, types.DepositTo)
+	require.Nil(t, err)
+
+	invariant := box.DepositedCoinsInvariant(keeper1)
+	require.Nil(t, invariant(ctx))
+
+	keeper1.GetBankKeeper().AddCoins(ctx, keeper.GetDepositedCoinsAddress(boxInfo.BoxId), sdk.NewCoins(deposit))
+	require.Nil(t, invariant(ctx))
+
+	_, err = keeper1.GetBankKeeper().SubtractCoins(ctx, keeper.GetDepositedCoinsAddress(boxInfo.BoxId), sdk.NewCoins(deposit, deposit))
+	require.Nil(t, err)
+	require.Error(t, invariant(ctx))
+}
@@ -4,8 +4,15 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	hashgardInit "github.com/hashgard/hashgard/init"
 	"github.com/hashgard/hashgard/x/box"
+	"github.com/hashgard/hashgard/x/box/errors"
+	boxkeeper "github.com/hashgard/hashgard/x/box/keeper"
 	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+	"github.com/hashgard/hashgard/x/box/utils"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -33,3 +40,110 @@ func TestGetLockBoxByAddress(t *testing.T) {
 
 	require.Len(t, issues, cap)
 }
+
+func TestDepositedCoinsAddress(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	handler := box.NewHandler(keeper)
+
+	boxInfo := GetLockBoxInfo()
+	keeper.GetBankKeeper().AddCoins(ctx, boxInfo.Sender, sdk.NewCoins(boxInfo.TotalAmount.Token))
+	res := handler(ctx, msgs.NewMsgLockBox(boxInfo))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+
+	require.True(t, keeper.IsDepositedCoinsAddress(ctx, boxkeeper.GetDepositedCoinsAddress(boxID)))
+	require.False(t, keeper.IsDepositedCoinsAddress(ctx, boxInfo.Sender))
+}
+
+func TestCreateBoxWithNonIssueDenom(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	handler := box.NewHandler(keeper)
+	hashgardInit.InitNativeCoinUnits()
+
+	for _, token := range []types.BoxToken{
+		{Token: sdk.NewCoin("agard", issueutils.MulDecimals(sdk.NewInt(100), 18)), Decimals: 18},
+		{Token: sdk.NewCoin("ugard", issueutils.MulDecimals(sdk.NewInt(100), 6)), Decimals: 6},
+		{Token: sdk.NewCoin("apple", sdk.NewInt(100)), Decimals: 0}} {
+		boxInfo := GetLockBoxInfo()
+		boxInfo.TotalAmount = token
+		keeper.GetBankKeeper().AddCoins(ctx, boxInfo.Sender, sdk.NewCoins(token.Token))
+		res := handler(ctx, msgs.NewMsgLockBox(boxInfo))
+		require.True(t, res.IsOK())
+
+		boxInfo.TotalAmount.Decimals = 8
+		keeper.GetBankKeeper().AddCoins(ctx, boxInfo.Sender, sdk.NewCoins(token.Token))
+		res = handler(ctx, msgs.NewMsgLockBox(boxInfo))
+		require.Equal(t, errors.CodeDecimalsNotValid, res.Code)
+	}
+
+	boxInfo := GetLockBoxInfo()
+	keeper.GetBankKeeper().AddCoins(ctx, boxInfo.Sender, sdk.NewCoins(boxInfo.TotalAmount.Token))
+	res := handler(ctx, msgs.NewMsgLockBox(boxInfo))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+
+	boxInfo.TotalAmount = types.BoxToken{Token: sdk.NewCoin(boxID, boxInfo.TotalAmount.Token.Amount)}
+	res = handler(ctx, msgs.NewMsgLockBox(boxInfo))
+	require.Equal(t, errors.CodeDenomNotSupported, res.Code)
+
+	depositBox := GetDepositBoxInfo()
+	depositBox.Deposit.Interest = types.BoxToken{
+		Token:    sdk.NewCoin("agard", issueutils.MulDecimals(sdk.NewInt(1000), 18)),
+		Decimals: 18}
+	depositBox.Deposit.PerCoupon = utils.CalcInterestRate(depositBox.TotalAmount.Token.Amount, depositBox.Deposit.Price,
+		depositBox.Deposit.Interest.Token.Amount, depositBox.Deposit.Interest.Decimals)
+	res = handler(ctx, msgs.NewMsgDepositBox(depositBox))
+	require.True(t, res.IsOK())
+}
+
+func TestTradeBankKeeperSendCoins(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	handler := box.NewHandler(keeper)
+
+	boxInfo := GetFutureBoxInfo()
+	boxInfo.TradeDisabled = true
+	res := handler(ctx, msgs.NewMsgFutureBox(boxInfo))
+	require.True(t, res.IsOK())
+
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+
+	keeper.GetBankKeeper().AddCoins(ctx, TransferAccAddr, sdk.NewCoins(boxInfo.TotalAmount.Token))
+	_, err := keeper.ProcessDepositToBox(ctx, boxID, TransferAccAddr, boxInfo.TotalAmount.Token, types.DepositTo)
+	require.Nil(t, err)
+
+	receiver, _ := sdk.AccAddressFromBech32(boxInfo.Future.Receivers[0][0])
+	coins := sdk.NewCoins(sdk.NewCoin(utils.GetCoinDenomByFutureBoxSeq(boxID, 1),
+		issueutils.MulDecimals(sdk.NewInt(100), TestTokenDecimals)))
+
+	ck := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	tradeBankKeeper := box.NewTradeBankKeeper(ck, keeper)
+
+	err = tradeBankKeeper.SendCoins(ctx, receiver, TransferAccAddr, coins)
+	require.Error(t, err)
+
+	err = tradeBankKeeper.InputOutputCoins(ctx,
+		[]bank.Input{bank.NewInput(receiver, coins)},
+		[]bank.Output{bank.NewOutput(TransferAccAddr, coins)})
+	require.Error(t, err)
+
+	_, err = keeper.DisableFeature(ctx, boxInfo.Sender, boxID, types.Trade)
+	require.Nil(t, err)
+
+	err = tradeBankKeeper.SendCoins(ctx, receiver, TransferAccAddr, coins)
+	require.Nil(t, err)
+}
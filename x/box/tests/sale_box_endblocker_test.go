@@ -0,0 +1,250 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+	issueutils "github.com/hashgard/hashgard/x/issue/utils"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestSaleBoxEndBlocker(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetSaleBoxInfo()
+	total := boxParams.TotalAmount.Token
+	price := boxParams.Sale.Price.Denom
+	keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, sdk.NewCoins(total.Add(total)))
+
+	buyer1 := TransferAccAddr
+	buyer2 := sdk.AccAddress(crypto.AddressHash([]byte("buyerAddress")))
+	keeper.GetBankKeeper().AddCoins(ctx, buyer1, sdk.NewCoins(sdk.NewCoin(price, sdk.NewInt(20000))))
+	keeper.GetBankKeeper().AddCoins(ctx, buyer2, sdk.NewCoins(sdk.NewCoin(price, sdk.NewInt(20000))))
+
+	var boxIDs [2]string
+	for i := range boxIDs {
+		res := handler(ctx, msgs.NewMsgSaleBox(boxParams))
+		require.True(t, res.IsOK())
+		keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxIDs[i])
+		require.Equal(t, types.BoxCreated, keeper.GetBox(ctx, boxIDs[i]).BoxStatus)
+	}
+
+	res := handler(ctx, msgs.NewMsgBoxDeposit(boxIDs[0], buyer1, sdk.NewCoin(price, sdk.NewInt(12000)), types.DepositTo))
+	require.False(t, res.IsOK())
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Sale.StartTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+	require.Equal(t, types.BoxDepositing, keeper.GetBox(ctx, boxIDs[0]).BoxStatus)
+
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxIDs[0], buyer1, total, types.DepositTo))
+	require.False(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxIDs[0], buyer1, sdk.NewCoin(price, sdk.NewInt(12000)), types.DepositTo))
+	require.True(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxIDs[0], buyer2, sdk.NewCoin(price, sdk.NewInt(10000)), types.DepositTo))
+	require.True(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxIDs[0], buyer2, sdk.NewCoin(price, sdk.NewInt(2000)), types.Fetch))
+	require.True(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxIDs[1], buyer2, sdk.NewCoin(price, sdk.NewInt(1000)), types.DepositTo))
+	require.True(t, res.IsOK())
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Sale.EndTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	inactiveQueue := keeper.ActiveBoxQueueIterator(ctx, ctx.BlockHeader().Time.Unix())
+	require.False(t, inactiveQueue.Valid())
+	inactiveQueue.Close()
+
+	// 20000 deposited against a hard cap of 10000, half of every deposit is accepted at 2 per token
+	boxInfo := keeper.GetBox(ctx, boxIDs[0])
+	require.Equal(t, types.BoxActived, boxInfo.BoxStatus)
+	require.Equal(t, sdk.NewInt(10000), boxInfo.Sale.Raised)
+	require.Equal(t, issueutils.MulDecimals(sdk.NewInt(5000), TestTokenDecimals), boxInfo.Sale.Sold)
+
+	coins := keeper.GetBankKeeper().GetCoins(ctx, buyer1)
+	require.Equal(t, issueutils.MulDecimals(sdk.NewInt(3000), TestTokenDecimals), coins.AmountOf(total.Denom))
+	require.Equal(t, sdk.NewInt(14000), coins.AmountOf(price))
+
+	coins = keeper.GetBankKeeper().GetCoins(ctx, buyer2)
+	require.Equal(t, issueutils.MulDecimals(sdk.NewInt(2000), TestTokenDecimals), coins.AmountOf(total.Denom))
+	require.Equal(t, sdk.NewInt(16000), coins.AmountOf(price))
+
+	// the second sale missed its soft cap and is refunded
+	require.Equal(t, types.BoxClosed, keeper.GetBox(ctx, boxIDs[1]).BoxStatus)
+
+	coins = keeper.GetBankKeeper().GetCoins(ctx, boxParams.Sender)
+	require.Equal(t, total.Amount.Add(issueutils.MulDecimals(sdk.NewInt(5000), TestTokenDecimals)), coins.AmountOf(total.Denom))
+	require.Equal(t, sdk.NewInt(10000), coins.AmountOf(price))
+
+	require.True(t, keeper.GetDepositedCoins(ctx, boxIDs[0]).IsZero())
+	require.True(t, keeper.GetDepositedCoins(ctx, boxIDs[1]).IsZero())
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+}
+
+func TestSaleBoxPriceNotValid(t *testing.T) {
+	for _, amount := range []int64{0, -1} {
+		boxParams := GetSaleBoxInfo()
+		boxParams.Sale.Price = sdk.Coin{Denom: boxParams.Sale.Price.Denom, Amount: sdk.NewInt(amount)}
+		require.NotPanics(t, func() {
+			require.Error(t, msgs.NewMsgSaleBox(boxParams).ValidateBasic())
+		})
+	}
+}
+
+type rejectingBankKeeper struct {
+	box.BankKeeper
+	addr  sdk.AccAddress
+	denom string
+}
+
+func (bk rejectingBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if toAddr.Equals(bk.addr) && amt.AmountOf(bk.denom).IsPositive() {
+		return sdk.ErrUnauthorized("receiver rejected")
+	}
+	return bk.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+func TestSaleBoxEndBlockerRefundsRejectedBuyer(t *testing.T) {
+	boxParams := GetSaleBoxInfo()
+	total := boxParams.TotalAmount.Token
+	price := boxParams.Sale.Price.Denom
+	buyer1 := TransferAccAddr
+	buyer2 := sdk.AccAddress(crypto.AddressHash([]byte("buyerAddress")))
+
+	mapp, keeper, _, _, _, _ := getMockAppWithBankKeeper(t, 10, box.DefaultGenesisState(), nil,
+		func(bk box.BankKeeper) box.BankKeeper {
+			return rejectingBankKeeper{bk, buyer2, total.Denom}
+		})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, sdk.NewCoins(total))
+	keeper.GetBankKeeper().AddCoins(ctx, buyer1, sdk.NewCoins(sdk.NewCoin(price, sdk.NewInt(20000))))
+	keeper.GetBankKeeper().AddCoins(ctx, buyer2, sdk.NewCoins(sdk.NewCoin(price, sdk.NewInt(20000))))
+
+	var boxID string
+	res := handler(ctx, msgs.NewMsgSaleBox(boxParams))
+	require.True(t, res.IsOK())
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Sale.StartTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxID, buyer1, sdk.NewCoin(price, sdk.NewInt(6000)), types.DepositTo))
+	require.True(t, res.IsOK())
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxID, buyer2, sdk.NewCoin(price, sdk.NewInt(4000)), types.DepositTo))
+	require.True(t, res.IsOK())
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Sale.EndTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	// buyer2 can not receive the token and gets its payment back, the sale still succeeds
+	boxInfo := keeper.GetBox(ctx, boxID)
+	require.Equal(t, types.BoxActived, boxInfo.BoxStatus)
+	require.Equal(t, sdk.NewInt(6000), boxInfo.Sale.Raised)
+	require.Equal(t, issueutils.MulDecimals(sdk.NewInt(3000), TestTokenDecimals), boxInfo.Sale.Sold)
+	require.Len(t, keeper.GetFailedBoxes(ctx, boxID), 0)
+
+	coins := keeper.GetBankKeeper().GetCoins(ctx, buyer1)
+	require.Equal(t, issueutils.MulDecimals(sdk.NewInt(3000), TestTokenDecimals), coins.AmountOf(total.Denom))
+	require.Equal(t, sdk.NewInt(14000), coins.AmountOf(price))
+
+	coins = keeper.GetBankKeeper().GetCoins(ctx, buyer2)
+	require.True(t, coins.AmountOf(total.Denom).IsZero())
+	require.Equal(t, sdk.NewInt(20000), coins.AmountOf(price))
+
+	coins = keeper.GetBankKeeper().GetCoins(ctx, boxParams.Sender)
+	require.Equal(t, total.Amount.Sub(issueutils.MulDecimals(sdk.NewInt(3000), TestTokenDecimals)), coins.AmountOf(total.Denom))
+	require.Equal(t, sdk.NewInt(6000), coins.AmountOf(price))
+
+	require.True(t, keeper.GetDepositedCoins(ctx, boxID).IsZero())
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+}
+
+func TestSaleBoxEndBlockerSettlesInBatches(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetSaleBoxInfo()
+	total := boxParams.TotalAmount.Token
+	price := boxParams.Sale.Price.Denom
+	keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, sdk.NewCoins(total))
+
+	var boxID string
+	res := handler(ctx, msgs.NewMsgSaleBox(boxParams))
+	require.True(t, res.IsOK())
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Sale.StartTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	buyers := make([]sdk.AccAddress, types.BoxMaxClaimBatch+1)
+	for i := range buyers {
+		buyers[i] = sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("buyer%d", i))))
+		keeper.GetBankKeeper().AddCoins(ctx, buyers[i], sdk.NewCoins(sdk.NewCoin(price, sdk.NewInt(50))))
+		res = handler(ctx, msgs.NewMsgBoxDeposit(boxID, buyers[i], sdk.NewCoin(price, sdk.NewInt(50)), types.DepositTo))
+		require.True(t, res.IsOK())
+	}
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = time.Unix(boxParams.Sale.EndTime, 0)
+	ctx = ctx.WithBlockHeader(newHeader)
+	box.EndBlocker(ctx, keeper)
+
+	// one deposit is left for the next block, the box takes no more deposits meanwhile
+	require.Equal(t, types.SaleBoxSettling, keeper.GetBox(ctx, boxID).BoxStatus)
+	require.Len(t, keeper.GetDueBoxQueueItems(ctx, ctx.BlockHeader().Time.Unix(), types.BoxMaxProcessPerBlock), 1)
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+	res = handler(ctx, msgs.NewMsgBoxDeposit(boxID, buyers[0], sdk.NewCoin(price, sdk.NewInt(1)), types.DepositTo))
+	require.False(t, res.IsOK())
+
+	box.EndBlocker(ctx, keeper)
+
+	boxInfo := keeper.GetBox(ctx, boxID)
+	require.Equal(t, types.BoxActived, boxInfo.BoxStatus)
+	require.Equal(t, sdk.NewInt(int64(50*len(buyers))), boxInfo.Sale.Raised)
+	require.Equal(t, issueutils.MulDecimals(sdk.NewInt(int64(25*len(buyers))), TestTokenDecimals), boxInfo.Sale.Sold)
+	for _, buyer := range buyers {
+		require.Equal(t, issueutils.MulDecimals(sdk.NewInt(25), TestTokenDecimals),
+			keeper.GetBankKeeper().GetCoins(ctx, buyer).AmountOf(total.Denom))
+	}
+	require.Len(t, keeper.GetDueBoxQueueItems(ctx, ctx.BlockHeader().Time.Unix(), types.BoxMaxProcessPerBlock), 0)
+	require.True(t, keeper.GetDepositedCoins(ctx, boxID).IsZero())
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+}
@@ -42,7 +42,7 @@ var (
 		TradeDisabled: true,
 		TotalAmount: types.BoxToken{
 			Token: sdk.NewCoin(
-				"text",
+				"coin174876e800",
 				issueutils.MulDecimals(sdk.NewInt(10000), TestTokenDecimals)),
 			Decimals: TestTokenDecimals},
 	}
@@ -71,7 +71,7 @@ func GetDepositBoxInfo() *params.BoxDepositParams {
 		Price:         issueutils.MulDecimals(sdk.NewInt(100), TestTokenDecimals),
 		Interest: types.BoxToken{
 			Token: sdk.NewCoin(
-				"interest",
+				"coin174876e801",
 				issueutils.MulDecimals(sdk.NewInt(1000), TestTokenDecimals)),
 			Decimals: TestTokenDecimals}}
 	box.Deposit.PerCoupon = utils.CalcInterestRate(box.TotalAmount.Token.Amount, box.Deposit.Price,
@@ -107,6 +107,55 @@ func GetFutureBoxInfo() *params.BoxFutureParams {
 	return box
 }
 
+func GetVestingBoxInfo() *params.BoxVestingParams {
+	start := time.Now().Add(time.Duration(30) * time.Second).Unix()
+	box := &params.BoxVestingParams{}
+	box.Sender = newBoxInfo.Owner
+	box.Name = newBoxInfo.Name
+	box.BoxType = types.Vesting
+	box.TotalAmount = newBoxInfo.TotalAmount
+	box.Vesting = types.VestingBox{
+		Beneficiary: TransferAccAddr,
+		StartTime:   start,
+		CliffTime:   start + 1000,
+		EndTime:     start + 4000,
+		Period:      1000,
+		Revocable:   true,
+		Claimed:     sdk.ZeroInt()}
+	return box
+}
+
+func GetEscrowBoxInfo() *params.BoxEscrowParams {
+	box := &params.BoxEscrowParams{}
+	box.Sender = newBoxInfo.Owner
+	box.Name = newBoxInfo.Name
+	box.BoxType = types.Escrow
+	box.TotalAmount = newBoxInfo.TotalAmount
+	box.Escrow = types.EscrowBox{
+		Beneficiary: TransferAccAddr,
+		Arbiter:     sdk.AccAddress(crypto.AddressHash([]byte("arbiterAddress"))),
+		ExpireTime:  time.Now().Add(time.Duration(30) * time.Second).Unix()}
+	return box
+}
+
+func GetSaleBoxInfo() *params.BoxSaleParams {
+	box := &params.BoxSaleParams{}
+	box.Sender = newBoxInfo.Owner
+	box.Name = newBoxInfo.Name
+	box.BoxType = types.Sale
+	box.TotalAmount = newBoxInfo.TotalAmount
+	box.Sale = types.SaleBox{
+		Price:        sdk.NewCoin("gard", sdk.NewInt(2)),
+		StartTime:    time.Now().Add(time.Duration(30) * time.Second).Unix(),
+		EndTime:      time.Now().Add(time.Duration(60) * time.Second).Unix(),
+		SoftCap:      sdk.NewInt(5000),
+		HardCap:      sdk.NewInt(10000),
+		TotalDeposit: sdk.ZeroInt(),
+		Raised:       sdk.ZeroInt(),
+		Sold:         sdk.ZeroInt()}
+	return box
+}
+
 // gov and staking endblocker
 func getEndBlocker(keeper keeper.Keeper) sdk.EndBlocker {
 	return func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
@@ -119,6 +168,14 @@ func getEndBlocker(keeper keeper.Keeper) sdk.EndBlocker {
 
 // initialize the mock application for this module
 func getMockApp(t *testing.T, numGenAccs int, genState box.GenesisState, genAccs []auth.Account) (
+	mapp *mock.App, keeper keeper.Keeper, sk staking.Keeper, addrs []sdk.AccAddress,
+	pubKeys []crypto.PubKey, privKeys []crypto.PrivKey) {
+	return getMockAppWithBankKeeper(t, numGenAccs, genState, genAccs, nil)
+}
+
+// initialize the mock application for this module, the bank keeper of the box is wrapped by wrapBankKeeper
+func getMockAppWithBankKeeper(t *testing.T, numGenAccs int, genState box.GenesisState, genAccs []auth.Account,
+	wrapBankKeeper func(box.BankKeeper) box.BankKeeper) (
 	mapp *mock.App, keeper keeper.Keeper, sk staking.Keeper, addrs []sdk.AccAddress,
 	pubKeys []crypto.PubKey, privKeys []crypto.PrivKey) {
 	mapp = mock.NewApp()
@@ -136,7 +193,11 @@ func getMockApp(t *testing.T, numGenAccs int, genState box.GenesisState, genAccs
 	ik := NewIssueKeeper()
 
 	sk = staking.NewKeeper(mapp.Cdc, keyStaking, tkeyStaking, ck, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
-	keeper = box.NewKeeper(mapp.Cdc, keyBox, pk, pk.Subspace("testBox"), ck, ik, types.DefaultCodespace)
+	var bk box.BankKeeper = ck
+	if wrapBankKeeper != nil {
+		bk = wrapBankKeeper(ck)
+	}
+	keeper = box.NewKeeper(mapp.Cdc, keyBox, pk, pk.Subspace("testBox"), bk, ik, types.DefaultCodespace)
 
 	mapp.Router().AddRoute(types.RouterKey, box.NewHandler(keeper))
 	mapp.QueryRouter().AddRoute(types.QuerierRoute, box.NewQuerier(keeper))
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/box"
+	"github.com/hashgard/hashgard/x/box/msgs"
+	"github.com/hashgard/hashgard/x/box/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func withBlockTime(ctx sdk.Context, unix int64) sdk.Context {
+	header := ctx.BlockHeader()
+	header.Time = time.Unix(unix, 0)
+	return ctx.WithBlockHeader(header)
+}
+
+func TestVestingBoxClaimAndRevoke(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 10, box.DefaultGenesisState(), nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.GetBankKeeper().SetSendEnabled(ctx, true)
+	handler := box.NewHandler(keeper)
+
+	boxParams := GetVestingBoxInfo()
+	total := boxParams.TotalAmount.Token
+	keeper.GetBankKeeper().AddCoins(ctx, boxParams.Sender, sdk.NewCoins(total))
+
+	res := handler(ctx, msgs.NewMsgVestingBox(boxParams))
+	require.True(t, res.IsOK())
+	var boxID string
+	keeper.Getcdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &boxID)
+	boxInfo := keeper.GetBox(ctx, boxID)
+	require.Equal(t, types.BoxActived, boxInfo.BoxStatus)
+	require.True(t, keeper.GetDepositedCoins(ctx, boxID).IsEqual(sdk.NewCoins(total)))
+
+	// nothing is claimable before the cliff
+	ctx = withBlockTime(ctx, boxParams.Vesting.CliffTime-1)
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, boxParams.Vesting.Beneficiary))
+	require.False(t, res.IsOK())
+
+	// only the beneficiary can claim
+	ctx = withBlockTime(ctx, boxParams.Vesting.CliffTime+500)
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, boxParams.Sender))
+	require.False(t, res.IsOK())
+
+	claimable, err := keeper.GetVestingBoxClaimable(ctx, boxID)
+	require.Nil(t, err)
+	require.Equal(t, total.Amount.QuoRaw(4), claimable.Claimable.Amount)
+
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, boxParams.Vesting.Beneficiary))
+	require.True(t, res.IsOK())
+	coins := keeper.GetBankKeeper().GetCoins(ctx, boxParams.Vesting.Beneficiary)
+	require.Equal(t, total.Amount.QuoRaw(4), coins.AmountOf(total.Denom))
+
+	// the owner revokes in the third period and gets back the unvested half
+	ctx = withBlockTime(ctx, boxParams.Vesting.StartTime+2500)
+	res = handler(ctx, msgs.NewMsgBoxRevoke(boxID, boxParams.Sender))
+	require.True(t, res.IsOK())
+	coins = keeper.GetBankKeeper().GetCoins(ctx, boxParams.Sender)
+	require.Equal(t, total.Amount.QuoRaw(2), coins.AmountOf(total.Denom))
+
+	res = handler(ctx, msgs.NewMsgBoxRevoke(boxID, boxParams.Sender))
+	require.False(t, res.IsOK())
+
+	// vesting stopped at the revoke time
+	ctx = withBlockTime(ctx, boxParams.Vesting.EndTime)
+	claimable, err = keeper.GetVestingBoxClaimable(ctx, boxID)
+	require.Nil(t, err)
+	require.Equal(t, total.Amount.QuoRaw(4), claimable.Claimable.Amount)
+
+	res = handler(ctx, msgs.NewMsgBoxClaim(boxID, boxParams.Vesting.Beneficiary))
+	require.True(t, res.IsOK())
+	coins = keeper.GetBankKeeper().GetCoins(ctx, boxParams.Vesting.Beneficiary)
+	require.Equal(t, total.Amount.QuoRaw(2), coins.AmountOf(total.Denom))
+
+	boxInfo = keeper.GetBox(ctx, boxID)
+	require.Equal(t, types.BoxFinished, boxInfo.BoxStatus)
+	require.True(t, keeper.GetDepositedCoins(ctx, boxID).IsZero())
+	require.Nil(t, box.DepositedCoinsInvariant(keeper)(ctx))
+}
@@ -25,3 +25,15 @@ func (bi BoxDeposit) String() string {
   Interest:			%s`,
 		bi.Amount.String(), bi.Interest.String())
 }
+
+//Deposit of an address in a box
+type AddressBoxDeposit struct {
+	BoxId    string         `json:"box_id"`
+	Address  sdk.AccAddress `json:"address"`
+	Amount   sdk.Int        `json:"amount"`
+	Interest sdk.Int        `json:"interest"`
+}
+
+func NewAddressBoxDeposit(boxID string, address sdk.AccAddress, boxDeposit BoxDeposit) AddressBoxDeposit {
+	return AddressBoxDeposit{boxID, address, boxDeposit.Amount, boxDeposit.Interest}
+}
@@ -45,6 +45,15 @@ type Box interface {
 	GetFuture() FutureBox
 	SetFuture(FutureBox)
 
+	GetVesting() VestingBox
+	SetVesting(VestingBox)
+
+	GetEscrow() EscrowBox
+	SetEscrow(EscrowBox)
+
+	GetSale() SaleBox
+	SetSale(SaleBox)
+
 	String() string
 }
 
@@ -66,6 +75,9 @@ type BoxInfo struct {
 	Lock          LockBox        `json:"lock"`
 	Deposit       DepositBox     `json:"deposit"`
 	Future        FutureBox      `json:"future"`
+	Vesting       VestingBox     `json:"vesting"`
+	Escrow        EscrowBox      `json:"escrow"`
+	Sale          SaleBox        `json:"sale"`
 }
 
 // Implements Box Interface
@@ -149,6 +161,27 @@ func (bi *BoxInfo) SetFuture(future FutureBox) {
 	bi.Future = future
 }
 
+func (bi BoxInfo) GetVesting() VestingBox {
+	return bi.Vesting
+}
+func (bi *BoxInfo) SetVesting(vesting VestingBox) {
+	bi.Vesting = vesting
+}
+
+func (bi BoxInfo) GetEscrow() EscrowBox {
+	return bi.Escrow
+}
+func (bi *BoxInfo) SetEscrow(escrow EscrowBox) {
+	bi.Escrow = escrow
+}
+
+func (bi BoxInfo) GetSale() SaleBox {
+	return bi.Sale
+}
+func (bi *BoxInfo) SetSale(sale SaleBox) {
+	bi.Sale = sale
+}
+
 type AddressDeposit struct {
 	Address sdk.AccAddress `json:"address"`
 	Amount  sdk.Int        `json:"amount"`
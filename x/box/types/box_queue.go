@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+//Entry of the active box queue, future boxes are queued as "boxID:seq"
+type BoxQueueItem struct {
+	EndTime int64  `json:"end_time"`
+	BoxId   string `json:"box_id"`
+}
+
+func NewBoxQueueItem(endTime int64, boxIdStr string) BoxQueueItem {
+	return BoxQueueItem{endTime, boxIdStr}
+}
+
+//Active box queue entry whose processing failed in the end blocker, kept until it is retried
+type BoxFailure struct {
+	EndTime int64  `json:"end_time"`
+	BoxId   string `json:"box_id"`
+	Height  int64  `json:"height"`
+	Reason  string `json:"reason"`
+}
+
+func NewBoxFailure(item BoxQueueItem, height int64, reason string) BoxFailure {
+	return BoxFailure{item.EndTime, item.BoxId, height, reason}
+}
+
+type BoxFailures []BoxFailure
+
+//nolint
+func (bf BoxFailure) String() string {
+	return fmt.Sprintf(`
+  BoxId:			%s
+  EndTime:			%d
+  Height:			%d
+  Reason:			%s`,
+		bf.BoxId, bf.EndTime, bf.Height, bf.Reason)
+}
+
+//nolint
+func (bf BoxFailures) String() string {
+	out := fmt.Sprintf("%-20s|%-12s|%-10s|%s\n",
+		"BoxID", "EndTime", "Height", "Reason")
+	for _, failure := range bf {
+		out += fmt.Sprintf("%-20s|%-12d|%-10d|%s\n",
+			failure.BoxId, failure.EndTime, failure.Height, failure.Reason)
+	}
+	return strings.TrimSpace(out)
+}
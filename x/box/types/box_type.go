@@ -10,9 +10,12 @@ const (
 	Lock    = "lock"
 	Deposit = "deposit"
 	Future  = "future"
+	Vesting = "vesting"
+	Escrow  = "escrow"
+	Sale    = "sale"
 )
 
-var BoxType = map[string]string{Lock: "aa", Deposit: "ab", Future: "ac"}
+var BoxType = map[string]string{Lock: "aa", Deposit: "ab", Future: "ac", Vesting: "ad", Escrow: "ae", Sale: "af"}
 
 func GetMustBoxTypeValue(boxType string) string {
 
@@ -40,3 +43,13 @@ func GetBoxTypeValue(boxType string) (string, error) {
 	}
 	return value, nil
 }
+
+// Whether the holders of the coins of a box type may trade them
+func IsTradable(boxType string) bool {
+	switch boxType {
+	case Deposit, Future:
+		return true
+	default:
+		return false
+	}
+}
@@ -18,6 +18,29 @@ type DepositBox struct {
 	Share              sdk.Int          `json:"share"`
 	TotalDeposit       sdk.Int          `json:"total_deposit"`
 	InterestInjections []AddressDeposit `json:"interest_injections"`
+	EarlyWithdraw      bool             `json:"early_withdraw"`
+	PenaltyRate        sdk.Dec          `json:"penalty_rate"`
+	Penalty            sdk.Int          `json:"penalty"`
+	AutoClaim          bool             `json:"auto_claim"`
+	CouponPayout       sdk.Coins        `json:"coupon_payout"`
+	Claimed            sdk.Int          `json:"claimed"`
+}
+
+// Returns the penalty charged on an amount of principal withdrawn before maturity
+func (bi DepositBox) GetPenalty(amount sdk.Int) sdk.Int {
+	if bi.PenaltyRate.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return bi.PenaltyRate.MulInt(amount).TruncateInt()
+}
+
+// Returns the principal and interest paid for a number of coupons of a matured deposit box
+func (bi DepositBox) GetPayout(share sdk.Int) sdk.Coins {
+	payout := sdk.Coins{}
+	for _, coin := range bi.CouponPayout {
+		payout = payout.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.Mul(share))))
+	}
+	return payout
 }
 
 type DepositBoxDepositInterest struct {
@@ -32,6 +55,18 @@ func NewDepositBoxDepositInterest(address sdk.AccAddress, amount sdk.Int, intere
 
 type DepositBoxDepositInterestList []DepositBoxDepositInterest
 
+type DepositBoxUnclaimed struct {
+	Address sdk.AccAddress `json:"address"`
+	Amount  sdk.Int        `json:"amount"`
+	Payout  sdk.Coins      `json:"payout"`
+}
+
+func NewDepositBoxUnclaimed(address sdk.AccAddress, amount sdk.Int, payout sdk.Coins) DepositBoxUnclaimed {
+	return DepositBoxUnclaimed{address, amount, payout}
+}
+
+type DepositBoxUnclaimedList []DepositBoxUnclaimed
+
 //nolint
 func (bi DepositBoxDepositInterest) String() string {
 	return fmt.Sprintf(`
@@ -53,7 +88,13 @@ func (bi DepositBox) String() string {
   PerCoupon:			%s
   Share:			%s
   TotalDeposit:			%s
-  InterestInjection:			%s`,
+  InterestInjection:			%s
+  EarlyWithdraw:			%t
+  PenaltyRate:			%s
+  Penalty:			%s
+  AutoClaim:			%t
+  CouponPayout:			%s
+  Claimed:			%s`,
 		bi.StartTime,
 		bi.EstablishTime,
 		bi.MaturityTime,
@@ -63,7 +104,13 @@ func (bi DepositBox) String() string {
 		bi.PerCoupon.String(),
 		bi.Share.String(),
 		bi.TotalDeposit.String(),
-		bi.InterestInjections)
+		bi.InterestInjections,
+		bi.EarlyWithdraw,
+		bi.PenaltyRate.String(),
+		bi.Penalty.String(),
+		bi.AutoClaim,
+		bi.CouponPayout.String(),
+		bi.Claimed.String())
 }
 
 //nolint
@@ -76,3 +123,14 @@ func (bi DepositBoxDepositInterestList) String() string {
 	}
 	return strings.TrimSpace(out)
 }
+
+//nolint
+func (bi DepositBoxUnclaimedList) String() string {
+	out := fmt.Sprintf("%-44s|%-40s|%s\n",
+		"Address", "Amount", "Payout")
+	for _, box := range bi {
+		out += fmt.Sprintf("%-44s|%-40s|%s\n",
+			box.Address.String(), box.Amount.String(), box.Payout.String())
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EscrowBox holds the total amount of the owner until the arbiter or the confirmation of the beneficiary
+// releases it to the beneficiary, the arbiter may refund it to the owner, and it is refunded at ExpireTime
+type EscrowBox struct {
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Arbiter     sdk.AccAddress `json:"arbiter"`
+	ExpireTime  int64          `json:"expire_time"`
+}
+
+// Whether an address may release the escrow to the beneficiary
+func (bi EscrowBox) CanRelease(sender sdk.AccAddress) bool {
+	return sender.Equals(bi.Arbiter) || sender.Equals(bi.Beneficiary)
+}
+
+// Whether an address may refund the escrow to the owner
+func (bi EscrowBox) CanRefund(sender sdk.AccAddress) bool {
+	return sender.Equals(bi.Arbiter)
+}
+
+//nolint
+func (bi EscrowBox) String() string {
+	return fmt.Sprintf(`EscrowInfo:
+  Beneficiary:			%s
+  Arbiter:			%s
+  ExpireTime:			%d`,
+		bi.Beneficiary.String(), bi.Arbiter.String(), bi.ExpireTime)
+}
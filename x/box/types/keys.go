@@ -25,6 +25,8 @@ var (
 	BoxMinId                uint64 = 10000000000000
 	BoxMaxInstalment               = 99
 	BoxMaxInjectionInterest        = 100
+	BoxMaxClaimBatch               = 100
+	BoxMaxProcessPerBlock   uint64 = 100
 )
 
 const (
@@ -38,6 +40,10 @@ const (
 	QueryDepositList   = "deposit"
 	QueryDepositAmount = "deposit-amount"
 	QuerySearch        = "search"
+	QueryClaimable     = "claimable"
+	QueryEscrow        = "escrow"
+	QueryUnclaimed     = "unclaimed"
+	QueryFailed        = "failed"
 )
 
 //box status
@@ -49,6 +55,11 @@ const (
 	BoxFinished   = "finished"
 )
 
+//status tagged when the end blocker fails to process a box
+const (
+	BoxFailed = "failed"
+)
+
 //lock box status
 const (
 	LockBoxLocked   = "locked"
@@ -58,11 +69,19 @@ const (
 //deposit box status
 const (
 	DepositBoxInterest = "interest"
+	DepositBoxMatured  = "matured"
+)
+
+//sale box status
+const (
+	SaleBoxSettling = "settling"
 )
 const (
 	Injection = "injection"
 	DepositTo = "deposit-to"
 	Fetch     = "fetch"
+	Release   = "release"
+	Refund    = "refund"
 )
 
 const (
@@ -72,6 +91,12 @@ const (
 	TypeMsgBoxFuture         = "box_future"
 	TypeMsgBoxDescription    = "box_description"
 	TypeMsgBoxDisableFeature = "box_disable_feature"
+	TypeMsgBoxClaim          = "box_claim"
+	TypeMsgBoxRevoke         = "box_revoke"
+	TypeMsgBoxRelease        = "box_release"
+	TypeMsgBoxRefund         = "box_refund"
+	TypeMsgBoxWithdraw       = "box_withdraw"
+	TypeMsgBoxRetry          = "box_retry"
 )
 const (
 	KeyDelimiterString                   = ":"
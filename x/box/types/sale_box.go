@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SaleBox sells the total amount at Price, the payment for one whole token, between StartTime and EndTime.
+// The sale succeeds when the payments reach SoftCap, payments above HardCap are accepted pro-rata
+type SaleBox struct {
+	Price        sdk.Coin `json:"price"`
+	StartTime    int64    `json:"start_time"`
+	EndTime      int64    `json:"end_time"`
+	SoftCap      sdk.Int  `json:"soft_cap"`
+	HardCap      sdk.Int  `json:"hard_cap"`
+	TotalDeposit sdk.Int  `json:"total_deposit"`
+	Raised       sdk.Int  `json:"raised"`
+	Sold         sdk.Int  `json:"sold"`
+}
+
+// Returns the payment accepted from a deposit, deposits are scaled down pro-rata when they exceed the hard cap
+func (bi SaleBox) GetAcceptedAmount(deposit sdk.Int) sdk.Int {
+	if bi.TotalDeposit.LTE(bi.HardCap) {
+		return deposit
+	}
+	return deposit.Mul(bi.HardCap).Quo(bi.TotalDeposit)
+}
+
+// Returns the amount of tokens with decimals bought by a payment
+func (bi SaleBox) GetTokenAmount(payment sdk.Int, decimals uint) sdk.Int {
+	return payment.Mul(sdk.NewIntWithDecimal(1, int(decimals))).Quo(bi.Price.Amount)
+}
+
+//nolint
+func (bi SaleBox) String() string {
+	return fmt.Sprintf(`SaleInfo:
+  Price:			%s
+  StartTime:			%d
+  EndTime:			%d
+  SoftCap:			%s
+  HardCap:			%s
+  TotalDeposit:			%s
+  Raised:			%s
+  Sold:			%s`,
+		bi.Price.String(), bi.StartTime, bi.EndTime, bi.SoftCap.String(), bi.HardCap.String(),
+		bi.TotalDeposit.String(), bi.Raised.String(), bi.Sold.String())
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingBox releases the total amount to the beneficiary between StartTime and EndTime,
+// nothing is released before CliffTime. A zero Period releases linearly, otherwise every Period seconds
+type VestingBox struct {
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	StartTime   int64          `json:"start_time"`
+	CliffTime   int64          `json:"cliff_time"`
+	EndTime     int64          `json:"end_time"`
+	Period      int64          `json:"period"`
+	Revocable   bool           `json:"revocable"`
+	RevokeTime  int64          `json:"revoke_time"`
+	Claimed     sdk.Int        `json:"claimed"`
+}
+
+// Whether the owner has revoked the vesting
+func (bi VestingBox) IsRevoked() bool {
+	return bi.RevokeTime > 0
+}
+
+// Returns the amount of total vested at a time, vesting stops at the revoke time
+func (bi VestingBox) GetVestedAmount(total sdk.Int, time int64) sdk.Int {
+	if bi.IsRevoked() && time > bi.RevokeTime {
+		time = bi.RevokeTime
+	}
+	if time < bi.CliffTime || time <= bi.StartTime {
+		return sdk.ZeroInt()
+	}
+	if time >= bi.EndTime {
+		return total
+	}
+	elapsed := time - bi.StartTime
+	if bi.Period > 0 {
+		elapsed = elapsed / bi.Period * bi.Period
+	}
+	return total.MulRaw(elapsed).QuoRaw(bi.EndTime - bi.StartTime)
+}
+
+// Returns the amount of total the beneficiary receives in the end
+func (bi VestingBox) GetFinalAmount(total sdk.Int) sdk.Int {
+	if bi.IsRevoked() {
+		return bi.GetVestedAmount(total, bi.RevokeTime)
+	}
+	return total
+}
+
+// Returns the amount of total vested at a time and not claimed yet
+func (bi VestingBox) GetClaimableAmount(total sdk.Int, time int64) sdk.Int {
+	return bi.GetVestedAmount(total, time).Sub(bi.Claimed)
+}
+
+//nolint
+func (bi VestingBox) String() string {
+	return fmt.Sprintf(`VestingInfo:
+  Beneficiary:			%s
+  StartTime:			%d
+  CliffTime:			%d
+  EndTime:			%d
+  Period:			%d
+  Revocable:			%t
+  RevokeTime:			%d
+  Claimed:			%s`,
+		bi.Beneficiary.String(), bi.StartTime, bi.CliffTime, bi.EndTime, bi.Period,
+		bi.Revocable, bi.RevokeTime, bi.Claimed.String())
+}
+
+// VestingBoxClaimable is the vesting state of a box at a time
+type VestingBoxClaimable struct {
+	BoxId       string         `json:"box_id"`
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Time        int64          `json:"time"`
+	Vested      sdk.Coin       `json:"vested"`
+	Claimed     sdk.Coin       `json:"claimed"`
+	Claimable   sdk.Coin       `json:"claimable"`
+}
+
+//nolint
+func (bi VestingBoxClaimable) String() string {
+	return fmt.Sprintf(`VestingClaimable:
+  BoxId:			%s
+  Beneficiary:			%s
+  Time:				%d
+  Vested:			%s
+  Claimed:			%s
+  Claimable:			%s`,
+		bi.BoxId, bi.Beneficiary.String(), bi.Time, bi.Vested.String(), bi.Claimed.String(), bi.Claimable.String())
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashgard/hashgard/x/box/errors"
 	"github.com/hashgard/hashgard/x/box/types"
 
+	issuetypes "github.com/hashgard/hashgard/x/issue/types"
 	issueutils "github.com/hashgard/hashgard/x/issue/utils"
 )
 
@@ -27,6 +28,36 @@ func CheckBoxId(boxID string) sdk.Error {
 	return nil
 }
 
+//Returns the decimals of a native coin unit registered by sdk.RegisterDenom, counted from the whole unit, e.g. 18 for agard
+func GetNativeDenomDecimals(denom string) (uint, bool) {
+	unit, ok := sdk.GetDenomUnit(denom)
+	if !ok {
+		return 0, false
+	}
+	for decimals := uint(0); decimals <= issuetypes.CoinDecimalsMaxValue; decimals++ {
+		if unit.MulInt(issueutils.GetDecimalsInt(decimals)).GTE(sdk.OneDec()) {
+			return decimals, true
+		}
+	}
+	return 0, false
+}
+
+//Returns the decimals of a denom, issued coins from the issue, native units from the registered units, otherwise 0
+func GetDenomDecimals(cdc *codec.Codec, cliCtx context.CLIContext, denom string) (uint, error) {
+	if IsBoxId(denom) {
+		return 0, errors.Errorf(errors.ErrDenomNotSupported(denom))
+	}
+	if issueutils.IsIssueId(denom) {
+		issueInfo, err := issueutils.GetIssueByID(cdc, cliCtx, denom)
+		if err != nil {
+			return 0, err
+		}
+		return issueInfo.GetDecimals(), nil
+	}
+	decimals, _ := GetNativeDenomDecimals(denom)
+	return decimals, nil
+}
+
 func CalcInterestRate(totalAmount sdk.Int, price sdk.Int, interest sdk.Int, decimals uint) sdk.Dec {
 	totalCoupon := totalAmount.Quo(price)
 	perCoupon := sdk.NewDecFromBigInt(interest.BigInt()).QuoInt(totalCoupon)
@@ -54,8 +85,24 @@ func BoxOwnerCheck(cdc *codec.Codec, cliCtx context.CLIContext, sender auth.Acco
 	}
 	return boxInfo, nil
 }
+func CheckBoxTrade(cdc *codec.Codec, cliCtx context.CLIContext, denom string) error {
+	boxInfo, err := GetBoxByID(cdc, cliCtx, denom)
+	if err != nil && GetBoxTypeByValue(denom) == types.Future && len(denom) > 2 {
+		boxInfo, err = GetBoxByID(cdc, cliCtx, GetBoxIdFromFutureBoxSeq(denom))
+	}
+	if err != nil {
+		return err
+	}
+	if boxInfo.IsTradeDisabled() {
+		return errors.Errorf(errors.ErrCanNotTrade(boxInfo.GetBoxId()))
+	}
+	return nil
+}
 func GetBoxCoinByDecimal(cdc *codec.Codec, cliCtx context.CLIContext, coin sdk.Coin) sdk.Coin {
 
+	if !issueutils.IsIssueId(coin.Denom) {
+		return coin
+	}
 	issueInfo, _ := issueutils.GetIssueByID(cdc, cliCtx, coin.Denom)
 
 	return sdk.Coin{fmt.Sprintf("%s(%s)", issueInfo.GetName(), coin.Denom), issueutils.QuoDecimals(coin.Amount, issueInfo.GetDecimals())}
@@ -78,6 +125,9 @@ func GetSeqFromFutureBoxSeq(boxSeqStr string) int {
 	seq, _ := strconv.Atoi(seqStr)
 	return seq
 }
+func GetBoxIdFromFutureBoxSeq(boxSeqStr string) string {
+	return boxSeqStr[:len(boxSeqStr)-2]
+}
 func GetMaxPrecision(dec sdk.Dec, decimals uint) sdk.Dec {
 	precision := types.MaxPrecision
 	if decimals < types.MaxPrecision {
@@ -11,19 +11,43 @@ type (
 	Keeper = keeper.Keeper
 	Order  = types.Order
 	Orders = types.Orders
+	Fill   = types.Fill
+	Depth  = types.Depth
+	Candle = types.Candle
+
+	TimeInForce    = types.TimeInForce
+	ExchangeParams = types.ExchangeParams
+	PairFee        = types.PairFee
+	Pool           = types.Pool
+	Pools          = types.Pools
+	SwapQuote      = types.SwapQuote
 )
 
 var (
-	NewKeeper = keeper.NewKeeper
+	NewKeeper       = keeper.NewKeeper
+	NewTimeInForce  = types.NewTimeInForce
+	IsModuleAccount = keeper.IsModuleAccount
+
+	FrozenCoinsAccAddr  = keeper.FrozenCoinsAccAddr
+	PoolReservesAccAddr = keeper.PoolReservesAccAddr
 
 	RegisterCodec         = msgs.RegisterCodec
 	NewMsgCreateOrder     = msgs.NewMsgCreateOrder
 	NewMsgWithdrawalOrder = msgs.NewMsgWithdrawalOrder
 	NewMsgTakeOrder       = msgs.NewMsgTakeOrder
+	NewMsgCreatePool      = msgs.NewMsgCreatePool
+	NewMsgAddLiquidity    = msgs.NewMsgAddLiquidity
+	NewMsgRemoveLiquidity = msgs.NewMsgRemoveLiquidity
+	NewMsgSwap            = msgs.NewMsgSwap
 
 	NewQueryOrderParams      = queriers.NewQueryOrderParams
 	NewQueryOrdersParams     = queriers.NewQueryOrdersParams
 	NewQueryFrozenFundParams = queriers.NewQueryFrozenFundParams
+	NewQueryDepthParams      = queriers.NewQueryDepthParams
+	NewQueryTradesParams     = queriers.NewQueryTradesParams
+	NewQueryCandlesParams    = queriers.NewQueryCandlesParams
+	NewQueryPoolParams       = queriers.NewQueryPoolParams
+	NewQueryQuoteParams      = queriers.NewQueryQuoteParams
 )
 
 const (
@@ -32,4 +56,10 @@ const (
 	QuerierRoute      = types.QuerierRoute
 	DefaultParamspace = types.DefaultParamspace
 	DefaultCodespace  = types.DefaultCodespace
+	StoreVersion      = keeper.StoreVersion
+
+	GTC = types.GTC
+	GTT = types.GTT
+	IOC = types.IOC
+	FOK = types.FOK
 )
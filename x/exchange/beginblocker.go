@@ -0,0 +1,22 @@
+package exchange
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+// Called every block, migrates the store to the current layout in batches after an upgrade
+func BeginBlocker(ctx sdk.Context, keeper Keeper) {
+	if keeper.GetStoreVersion(ctx) >= StoreVersion {
+		return
+	}
+	migrated, done := keeper.MigrateStore(ctx)
+	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
+	logger.Info(fmt.Sprintf("migrated %d orders to store version %d", migrated, StoreVersion))
+	if done {
+		logger.Info(fmt.Sprintf("migrated the store to version %d", StoreVersion))
+	}
+}
@@ -1,7 +1,21 @@
 package cli
 
 const (
-	FlagSupply = "supply"
-	FlagTarget = "target"
-	FlagAmount = "amount"
+	FlagSupply       = "supply"
+	FlagTarget       = "target"
+	FlagAmount       = "amount"
+	FlagTimeInForce  = "time-in-force"
+	FlagExpireTime   = "expire-time"
+	FlagExpireHeight = "expire-height"
+	FlagLimit        = "limit"
+	FlagInterval     = "interval"
+	FlagSupplyDenom  = "supply-denom"
+	FlagTargetDenom  = "target-denom"
+	FlagStartOrderId = "start-order-id"
+	FlagDeposit      = "deposit"
+	FlagMinShares    = "min-shares"
+	FlagShares       = "shares"
+	FlagMinWithdraw  = "min-withdraw"
+	FlagInput        = "input"
+	FlagMinOutput    = "min-output"
 )
@@ -4,16 +4,44 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/hashgard/hashgard/x/exchange/queriers"
 	"github.com/hashgard/hashgard/x/exchange/types"
 )
 
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the exchange params",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange params
+
+Fee rates are in basis points of the turnover, the maker fee is paid in the target coin
+of the filled order and the taker fee in its supply coin.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.ExchangeParams
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
 func GetCmdQueryOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "query-order [order-id]",
@@ -50,22 +78,30 @@ $ hashgardcli exchange query-order 1
 }
 
 func GetCmdQueryOrdersByAddr(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "query-orders [address]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query all orders of a specific address",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the orders of a specific address or trading pair",
 		Long: strings.TrimSpace(`
 $ hashgardcli exchange query-orders gard1hf4n743fujvxrwx8af7u35anjqpdd2cx8p6cdd
+$ hashgardcli exchange query-orders --supply-denom=gard --target-denom=apple --start-order-id=100 --limit=10
+
+Orders are listed by order id, the next page starts after the id of the last order.
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			seller, err := sdk.AccAddressFromBech32(args[0])
-			if err != nil {
-				return err
+			var seller sdk.AccAddress
+			if len(args) > 0 {
+				var err error
+				seller, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
 			}
 
-			params := queriers.NewQueryOrdersParams(seller)
+			params := queriers.NewQueryOrdersParams(seller, viper.GetString(FlagSupplyDenom), viper.GetString(FlagTargetDenom),
+				viper.GetUint64(FlagStartOrderId), viper.GetInt(FlagLimit))
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -81,6 +117,13 @@ $ hashgardcli exchange query-orders gard1hf4n743fujvxrwx8af7u35anjqpdd2cx8p6cdd
 			return cliCtx.PrintOutput(orders)
 		},
 	}
+
+	cmd.Flags().String(FlagSupplyDenom, "", "filter orders by supply denom")
+	cmd.Flags().String(FlagTargetDenom, "", "filter orders by target denom")
+	cmd.Flags().Uint64(FlagStartOrderId, 0, "order id the page starts with")
+	cmd.Flags().Int(FlagLimit, 30, "maximum number of orders")
+
+	return cmd
 }
 
 func GetCmdFrozenFund(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -116,3 +159,207 @@ $ hashgardcli exchange query-frozen gard1hf4n743fujvxrwx8af7u35anjqpdd2cx8p6cdd
 		},
 	}
 }
+
+func GetCmdQueryDepth(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "query-depth [supply-denom] [target-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the order book depth of a pair",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange query-depth gard apple
+
+Asks sell the supply denom and bids buy it, prices are in target denom per supply denom.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := queriers.NewQueryDepthParams(args[0], args[1])
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/depth", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var depth types.Depth
+			cdc.MustUnmarshalJSON(res, &depth)
+			return cliCtx.PrintOutput(depth)
+		},
+	}
+}
+
+func GetCmdQueryTrades(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-trades [base-denom] [quote-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the latest trades of a pair",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange query-trades gard apple --limit=10
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := queriers.NewQueryTradesParams(args[0], args[1], viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/trades", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var trades types.Fills
+			cdc.MustUnmarshalJSON(res, &trades)
+			return cliCtx.PrintOutput(trades)
+		},
+	}
+
+	cmd.Flags().Int(FlagLimit, 30, "maximum number of trades")
+
+	return cmd
+}
+
+func GetCmdQueryCandles(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-candles [base-denom] [quote-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the OHLCV candles of a pair",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange query-candles gard apple --interval=1h --limit=24
+
+Prices are in quote denom per base denom and volumes are in base denom.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			interval, err := time.ParseDuration(viper.GetString(FlagInterval))
+			if err != nil {
+				return err
+			}
+			if interval < time.Second {
+				return fmt.Errorf("interval must be at least one second")
+			}
+
+			params := queriers.NewQueryCandlesParams(args[0], args[1], int64(interval/time.Second), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/candles", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var candles types.Candles
+			cdc.MustUnmarshalJSON(res, &candles)
+			return cliCtx.PrintOutput(candles)
+		},
+	}
+
+	cmd.Flags().String(FlagInterval, "1h", "interval of the candles, such as 1m, 15m, 1h or 24h")
+	cmd.Flags().Int(FlagLimit, 30, "maximum number of candles")
+
+	return cmd
+}
+
+func GetCmdQueryPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "query-pool [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the reserves and shares of a liquidity pool",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange query-pool 1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, please input a valid pool-id", args[0])
+			}
+
+			params := queriers.NewQueryPoolParams(poolId)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/pool", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var pool types.Pool
+			cdc.MustUnmarshalJSON(res, &pool)
+			return cliCtx.PrintOutput(pool)
+		},
+	}
+}
+
+func GetCmdQueryPools(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-pools",
+		Args:  cobra.NoArgs,
+		Short: "Query all liquidity pools",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange list-pools
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/pools", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			var pools types.Pools
+			cdc.MustUnmarshalJSON(res, &pools)
+			return cliCtx.PrintOutput(pools)
+		},
+	}
+}
+
+func GetCmdQueryQuote(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "query-quote [pool-id] [input]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the output of a swap against the current reserves of a pool",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange query-quote 1 100gard
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, please input a valid pool-id", args[0])
+			}
+
+			input, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := queriers.NewQueryQuoteParams(poolId, input)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/quote", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			var quote types.SwapQuote
+			cdc.MustUnmarshalJSON(res, &quote)
+			return cliCtx.PrintOutput(quote)
+		},
+	}
+}
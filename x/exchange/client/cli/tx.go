@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/hashgard/hashgard/x/exchange/msgs"
+	"github.com/hashgard/hashgard/x/exchange/types"
 )
 
 func GetCmdCreateOrder(cdc *codec.Codec) *cobra.Command {
@@ -22,10 +23,15 @@ func GetCmdCreateOrder(cdc *codec.Codec) *cobra.Command {
 		Short: "create a new order",
 		Example: `
 $ hashgardcli exchange create-order --supply=100gard --target=800apple --from mykey
+$ hashgardcli exchange create-order --supply=100gard --target=800apple --time-in-force=GTT --expire-height=10000 --from mykey
 
 The supply must have specific amount and coin name, that's what you want to sell.
 So make sure your address have sufficient balance.
 The target is what you want to get by this order.
+The order is matched against the opposite orders of the pair at the best price first,
+the part which is not filled stays on the order book.
+The time-in-force is one of GTC (default), GTT (expire at --expire-time or --expire-height),
+IOC (return the part not filled immediately) and FOK (fill completely or fail).
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -61,7 +67,10 @@ The target is what you want to get by this order.
 				return err
 			}
 
-			msg := msgs.NewMsgCreateOrder(from, supply, target)
+			tif := types.NewTimeInForce(strings.ToUpper(viper.GetString(FlagTimeInForce)),
+				viper.GetInt64(FlagExpireTime), viper.GetInt64(FlagExpireHeight))
+
+			msg := msgs.NewMsgCreateOrder(from, supply, target, tif)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -73,6 +82,9 @@ The target is what you want to get by this order.
 
 	cmd.Flags().String(FlagSupply, "", "coin of supply")
 	cmd.Flags().String(FlagTarget, "", "coin of target")
+	cmd.Flags().String(FlagTimeInForce, types.GTC, "time in force of the order: GTC, GTT, IOC or FOK")
+	cmd.Flags().Int64(FlagExpireTime, 0, "unix time when a GTT order expires")
+	cmd.Flags().Int64(FlagExpireHeight, 0, "block height when a GTT order expires")
 
 	return cmd
 }
@@ -181,3 +193,195 @@ of corresponding amount.
 
 	return cmd
 }
+
+func GetCmdCreatePool(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-pool",
+		Short: "create a liquidity pool of two coins",
+		Example: `
+$ hashgardcli exchange create-pool --deposit=1000gard,5000apple --from mykey
+
+The deposit must be two coins, their ratio sets the initial price of the pool.
+The creator receives the initial shares of the pool, the share denom is pool followed by the pool id.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get from address
+			from := cliCtx.GetFromAddress()
+
+			deposit, err := sdk.ParseCoins(viper.GetString(FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			msg := msgs.NewMsgCreatePool(from, deposit)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(FlagDeposit, "", "coins of the initial deposit")
+
+	return cmd
+}
+
+func GetCmdAddLiquidity(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-liquidity [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "add liquidity to a pool",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange add-liquidity 1 --deposit=100gard,500apple --min-shares=200 --from mykey
+
+The deposit is the maximum of both coins, only the part in the ratio of the reserves is taken.
+The transaction fails if it mints less than --min-shares.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get from address
+			from := cliCtx.GetFromAddress()
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid int, please input a valid pool-id", args[0])
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			minShares, ok := sdk.NewIntFromString(viper.GetString(FlagMinShares))
+			if !ok {
+				return fmt.Errorf("min-shares %s not a valid int", viper.GetString(FlagMinShares))
+			}
+
+			msg := msgs.NewMsgAddLiquidity(poolId, from, deposit, minShares)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(FlagDeposit, "", "maximum coins of the deposit")
+	cmd.Flags().String(FlagMinShares, "0", "minimum shares to mint")
+
+	return cmd
+}
+
+func GetCmdRemoveLiquidity(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-liquidity [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "remove liquidity from a pool",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange remove-liquidity 1 --shares=200 --min-withdraw=90gard,450apple --from mykey
+
+The shares are burned and their part of the reserves is withdrawn.
+The transaction fails if less than --min-withdraw is withdrawn.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get from address
+			from := cliCtx.GetFromAddress()
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid int, please input a valid pool-id", args[0])
+			}
+
+			shares, ok := sdk.NewIntFromString(viper.GetString(FlagShares))
+			if !ok {
+				return fmt.Errorf("shares %s not a valid int", viper.GetString(FlagShares))
+			}
+
+			minWithdraw, err := sdk.ParseCoins(viper.GetString(FlagMinWithdraw))
+			if err != nil {
+				return err
+			}
+
+			msg := msgs.NewMsgRemoveLiquidity(poolId, from, shares, minWithdraw)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(FlagShares, "", "shares to burn")
+	cmd.Flags().String(FlagMinWithdraw, "", "minimum coins to withdraw")
+
+	return cmd
+}
+
+func GetCmdSwap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "swap a coin for the other coin of a pool",
+		Long: strings.TrimSpace(`
+$ hashgardcli exchange swap 1 --input=100gard --min-output=480apple --from mykey
+
+The pool fee is taken from the input and stays in the pool.
+The transaction fails if the output is less than --min-output.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get from address
+			from := cliCtx.GetFromAddress()
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid int, please input a valid pool-id", args[0])
+			}
+
+			input, err := sdk.ParseCoin(viper.GetString(FlagInput))
+			if err != nil {
+				return err
+			}
+
+			minOutput, err := sdk.ParseCoin(viper.GetString(FlagMinOutput))
+			if err != nil {
+				return err
+			}
+
+			msg := msgs.NewMsgSwap(poolId, from, input, minOutput)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+		},
+	}
+
+	cmd.Flags().String(FlagInput, "", "coin to swap")
+	cmd.Flags().String(FlagMinOutput, "", "minimum coin to receive")
+
+	return cmd
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -20,16 +22,53 @@ import (
 const (
 	RestOrderId = "order-id"
 	RestAddress = "address"
+	RestSupply  = "supply-denom"
+	RestTarget  = "target-denom"
+	RestBase    = "base-denom"
+	RestQuote   = "quote-denom"
+	RestPoolId  = "pool-id"
+
+	restLimit        = "limit"
+	restInterval     = "interval"
+	restSupplyDenom  = "supply_denom"
+	restTargetDenom  = "target_denom"
+	restStartOrderId = "start_order_id"
+	restInput        = "input"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc("/exchange/params", queryParamsHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/exchange/order/{%s}", RestOrderId), queryOrderHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/exchange/orders", queryOrdersByAddrHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/exchange/orders/{%s}", RestAddress), queryOrdersByAddrHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/exchange/frozen/{%s}", RestAddress), queryFrozenFundByAddrHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/exchange/depth/{%s}/{%s}", RestSupply, RestTarget), queryDepthHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/exchange/trades/{%s}/{%s}", RestBase, RestQuote), queryTradesHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/exchange/candles/{%s}/{%s}", RestBase, RestQuote), queryCandlesHandlerFn(cdc, cliCtx)).Methods("GET")
+
+	r.HandleFunc("/exchange/pools", queryPoolsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/exchange/pool/{%s}", RestPoolId), queryPoolHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/exchange/pool/{%s}/quote", RestPoolId), queryQuoteHandlerFn(cdc, cliCtx)).Methods("GET")
 
 	r.HandleFunc("/exchange/order", postOrderHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/exchange/take/{%s}", RestOrderId), postTakeOrderHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/exchange/withdrawal/{%s}", RestOrderId), postWithdrawalOrderHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/exchange/pool", postPoolHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/exchange/pool/{%s}/add-liquidity", RestPoolId), postAddLiquidityHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/exchange/pool/{%s}/remove-liquidity", RestPoolId), postRemoveLiquidityHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/exchange/pool/{%s}/swap", RestPoolId), postSwapHandlerFn(cdc, cliCtx)).Methods("POST")
+}
+
+func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryParams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
 }
 
 func queryOrderHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -69,15 +108,34 @@ func queryOrderHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 func queryOrdersByAddrHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		strAddress := vars[RestAddress]
 
-		address, err := sdk.AccAddressFromBech32(strAddress)
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		var address sdk.AccAddress
+		if strAddress := vars[RestAddress]; len(strAddress) > 0 {
+			var err error
+			address, err = sdk.AccAddressFromBech32(strAddress)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		var startOrderId uint64
+		if strStartOrderId := r.URL.Query().Get(restStartOrderId); len(strStartOrderId) > 0 {
+			var err error
+			startOrderId, err = strconv.ParseUint(strStartOrderId, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		limit, ok := parseLimit(w, r)
+		if !ok {
 			return
 		}
 
-		params := exchange.NewQueryOrdersParams(address)
+		params := exchange.NewQueryOrdersParams(address, r.URL.Query().Get(restSupplyDenom),
+			r.URL.Query().Get(restTargetDenom), startOrderId, limit)
 
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
@@ -85,7 +143,7 @@ func queryOrdersByAddrHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) htt
 			return
 		}
 
-		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryAllOrdersByAddress), bz)
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryOrders), bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -124,10 +182,115 @@ func queryFrozenFundByAddrHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext)
 	}
 }
 
+func queryDepthHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		params := exchange.NewQueryDepthParams(vars[RestSupply], vars[RestTarget])
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryDepth), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryTradesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		limit, ok := parseLimit(w, r)
+		if !ok {
+			return
+		}
+
+		params := exchange.NewQueryTradesParams(vars[RestBase], vars[RestQuote], limit)
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryTrades), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryCandlesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		limit, ok := parseLimit(w, r)
+		if !ok {
+			return
+		}
+
+		interval := time.Hour
+		if strInterval := r.URL.Query().Get(restInterval); len(strInterval) > 0 {
+			var err error
+			interval, err = time.ParseDuration(strInterval)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if interval < time.Second {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "interval must be at least one second")
+			return
+		}
+
+		params := exchange.NewQueryCandlesParams(vars[RestBase], vars[RestQuote], int64(interval/time.Second), limit)
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryCandles), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// Returns the limit query parameter, 30 if not set
+func parseLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	strLimit := r.URL.Query().Get(restLimit)
+	if len(strLimit) == 0 {
+		return 30, true
+	}
+	limit, err := strconv.Atoi(strLimit)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return 0, false
+	}
+	return limit, true
+}
+
 type PostOrderReq struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Supply  sdk.Coin     `json:"supply"`
-	Target  sdk.Coin     `json:"target"`
+	BaseReq     rest.BaseReq         `json:"base_req"`
+	Supply      sdk.Coin             `json:"supply"`
+	Target      sdk.Coin             `json:"target"`
+	TimeInForce exchange.TimeInForce `json:"time_in_force"`
 }
 
 type TakeOrderReq struct {
@@ -157,7 +320,7 @@ func postOrderHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 		}
 
 		// create the message
-		msg := exchange.NewMsgCreateOrder(fromAddress, req.Supply, req.Target)
+		msg := exchange.NewMsgCreateOrder(fromAddress, req.Supply, req.Target, req.TimeInForce)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 		}
@@ -247,3 +410,221 @@ func postWithdrawalOrderHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) h
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func queryPoolsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryPools), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryPoolHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		poolId, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[RestPoolId])
+		if !ok {
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(exchange.NewQueryPoolParams(poolId))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryPool), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryQuoteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		poolId, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[RestPoolId])
+		if !ok {
+			return
+		}
+
+		input, err := sdk.ParseCoin(r.URL.Query().Get(restInput))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(exchange.NewQueryQuoteParams(poolId, input))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", exchange.StoreKey, exchange.QueryQuote), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+type PostPoolReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Deposit sdk.Coins    `json:"deposit"`
+}
+
+type AddLiquidityReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	MaxDeposit sdk.Coins    `json:"max_deposit"`
+	MinShares  sdk.Int      `json:"min_shares"`
+}
+
+type RemoveLiquidityReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Shares      sdk.Int      `json:"shares"`
+	MinWithdraw sdk.Coins    `json:"min_withdraw"`
+}
+
+type SwapReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Input     sdk.Coin     `json:"input"`
+	MinOutput sdk.Coin     `json:"min_output"`
+}
+
+func postPoolHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PostPoolReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := exchange.NewMsgCreatePool(fromAddress, req.Deposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postAddLiquidityHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		poolId, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[RestPoolId])
+		if !ok {
+			return
+		}
+
+		var req AddLiquidityReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := exchange.NewMsgAddLiquidity(poolId, fromAddress, req.MaxDeposit, req.MinShares)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postRemoveLiquidityHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		poolId, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[RestPoolId])
+		if !ok {
+			return
+		}
+
+		var req RemoveLiquidityReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := exchange.NewMsgRemoveLiquidity(poolId, fromAddress, req.Shares, req.MinWithdraw)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postSwapHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		poolId, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[RestPoolId])
+		if !ok {
+			return
+		}
+
+		var req SwapReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := exchange.NewMsgSwap(poolId, fromAddress, req.Input, req.MinOutput)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
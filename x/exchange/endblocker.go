@@ -0,0 +1,46 @@
+package exchange
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/tags"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+// Called every block, expires the GTT orders which are due and returns their remains to the sellers.
+// An order whose remains can not be returned is taken out of the book and left to its seller to withdraw
+func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
+	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
+	resTags := sdk.NewTags()
+	// the expiry queues are rebuilt by the store migration
+	if keeper.CheckStoreVersion(ctx) != nil {
+		return resTags
+	}
+
+	for _, orderId := range keeper.GetExpiredOrderIds(ctx, types.MaxExpiredOrdersPerBlock) {
+		order, ok := keeper.GetOrder(ctx, orderId)
+		if !ok {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		amt, err := keeper.ExpireOrder(cacheCtx, order)
+		if err != nil {
+			keeper.FailExpireOrder(ctx, order)
+			logger.Error(fmt.Sprintf("order %d failed to expire: %s", orderId, err.Error()))
+			resTags = resTags.AppendTag(tags.OrderId, fmt.Sprintf("%d", orderId)).
+				AppendTag(tags.Sender, order.Seller.String()).
+				AppendTag(tags.OrderStatus, "expire-failed").
+				AppendTag(tags.Reason, err.Error())
+			continue
+		}
+		write()
+		logger.Debug(fmt.Sprintf("order %d expired, returned %s to %s", orderId, amt, order.Seller))
+		resTags = resTags.AppendTag(tags.OrderId, fmt.Sprintf("%d", orderId)).
+			AppendTag(tags.Sender, order.Seller.String()).
+			AppendTag(tags.OrderStatus, "expired")
+	}
+
+	return resTags
+}
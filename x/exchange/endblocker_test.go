@@ -0,0 +1,104 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestEndBlockerExpireOrder(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	balance := mapp.AccountKeeper.GetAccount(ctx, addrs[0]).GetCoins()
+
+	_, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200),
+		NewTimeInForce(GTT, 0, 10))
+	require.Error(t, err)
+
+	order, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200),
+		NewTimeInForce(GTT, 0, 12))
+	require.NoError(t, err)
+
+	require.Len(t, EndBlocker(ctx.WithBlockHeight(11), keeper), 0)
+	require.True(t, keeper.HasOrder(ctx, order.OrderId))
+
+	resTags := EndBlocker(ctx.WithBlockHeight(12), keeper)
+	require.Len(t, resTags, 3)
+	require.False(t, keeper.HasOrder(ctx, order.OrderId))
+	require.Len(t, keeper.GetAddressOrders(ctx, addrs[0]), 0)
+	require.True(t, balance.IsEqual(mapp.AccountKeeper.GetAccount(ctx, addrs[0]).GetCoins()))
+}
+
+func TestEndBlockerExpireOrderFailed(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	balance := mapp.AccountKeeper.GetAccount(ctx, addrs[0]).GetCoins()
+
+	order, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200),
+		NewTimeInForce(GTT, 0, 12))
+	require.NoError(t, err)
+
+	// the remains can not be returned while the frozen coins are missing
+	frozen := mapp.AccountKeeper.GetAccount(ctx, FrozenCoinsAccAddr)
+	frozenCoins := frozen.GetCoins()
+	require.NoError(t, frozen.SetCoins(sdk.Coins{}))
+	mapp.AccountKeeper.SetAccount(ctx, frozen)
+
+	resTags := EndBlocker(ctx.WithBlockHeight(12), keeper)
+	require.Len(t, resTags, 4)
+	require.True(t, keeper.HasOrder(ctx, order.OrderId))
+	require.Len(t, keeper.GetBookOrders(ctx, sdk.DefaultBondDenom, "foocoin"), 0)
+	require.Len(t, EndBlocker(ctx.WithBlockHeight(13), keeper), 0)
+
+	require.NoError(t, frozen.SetCoins(frozenCoins))
+	mapp.AccountKeeper.SetAccount(ctx, frozen)
+	_, err = keeper.WithdrawalOrder(ctx, order.OrderId, addrs[0])
+	require.NoError(t, err)
+	require.False(t, keeper.HasOrder(ctx, order.OrderId))
+	require.True(t, balance.IsEqual(mapp.AccountKeeper.GetAccount(ctx, addrs[0]).GetCoins()))
+}
+
+func TestCreateOrderTimeInForce(t *testing.T) {
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	acc2 := auth.NewBaseAccountWithAddress(Addrs[1])
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1000)))
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, GenesisState{}, []auth.Account{&acc1, &acc2})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	_, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	require.NoError(t, err)
+
+	// fill or kill fails when the book can not fill the whole order
+	_, _, err = keeper.CreateOrder(ctx, acc2.Address, sdk.NewInt64Coin("foocoin", 400), sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), NewTimeInForce(FOK, 0, 0))
+	require.Error(t, err)
+	require.Equal(t, int64(1000), mapp.AccountKeeper.GetAccount(ctx, acc2.Address).GetCoins().AmountOf("foocoin").Int64())
+
+	// immediate or cancel returns the part which is not filled
+	order, fills, err := keeper.CreateOrder(ctx, acc2.Address, sdk.NewInt64Coin("foocoin", 400), sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), NewTimeInForce(IOC, 0, 0))
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	require.False(t, keeper.HasOrder(ctx, order.OrderId))
+	coins := mapp.AccountKeeper.GetAccount(ctx, acc2.Address).GetCoins()
+	require.Equal(t, int64(800), coins.AmountOf("foocoin").Int64())
+	require.Equal(t, int64(100), coins.AmountOf(sdk.DefaultBondDenom).Int64())
+	require.Nil(t, FrozenCoinsInvariant(keeper)(ctx))
+}
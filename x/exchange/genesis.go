@@ -2,16 +2,22 @@ package exchange
 
 import (
 	"bytes"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/hashgard/hashgard/x/exchange/keeper"
 	"github.com/hashgard/hashgard/x/exchange/msgs"
+	"github.com/hashgard/hashgard/x/exchange/types"
 )
 
 type GenesisState struct {
-	StartingOrderId uint64  `json:"starting_order_id"`
-	Orders          []Order `json:"orders"`
+	StartingOrderId uint64               `json:"starting_order_id"`
+	Params          types.ExchangeParams `json:"params"`
+	Orders          []Order              `json:"orders"`
+	Pools           []Pool               `json:"pools"`
+	Trades          []Fill               `json:"trades"`
+	NextTradeId     uint64               `json:"next_trade_id"`
 }
 
 func NewGenesisState(startingOrderId uint64) GenesisState {
@@ -40,6 +46,21 @@ func (data GenesisState) IsEmpty() bool {
 }
 
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	for _, pool := range data.Pools {
+		if pool.ReserveA.Denom >= pool.ReserveB.Denom || pool.ShareDenom != types.GetShareDenom(pool.PoolId) {
+			return fmt.Errorf("invalid pool %d", pool.PoolId)
+		}
+	}
+	tradeIds := make(map[uint64]bool)
+	for _, trade := range data.Trades {
+		if trade.TradeId == 0 || tradeIds[trade.TradeId] || (data.NextTradeId > 0 && trade.TradeId >= data.NextTradeId) {
+			return fmt.Errorf("invalid trade %d", trade.TradeId)
+		}
+		tradeIds[trade.TradeId] = true
+	}
 	return nil
 }
 
@@ -49,14 +70,21 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) {
 		// TODO: Handle this with #870
 		panic(err)
 	}
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetStoreVersion(ctx, StoreVersion)
 
 	for _, order := range data.Orders {
 		keeper.SetOrder(ctx, order)
-		orderIdArr := keeper.GetAddressOrders(ctx, order.Seller)
-		orderIdArr = append(orderIdArr, order.OrderId)
-		keeper.SetAddressOrders(ctx, order.Seller, orderIdArr)
 	}
-
+	for _, pool := range data.Pools {
+		keeper.SetPool(ctx, pool)
+	}
+	for _, trade := range data.Trades {
+		keeper.SetTrade(ctx, trade)
+	}
+	if data.NextTradeId > keeper.PeekNextTradeId(ctx) {
+		keeper.SetNextTradeId(ctx, data.NextTradeId)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) GenesisState {
@@ -64,10 +92,14 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) GenesisState {
 
 	var orders []Order
 
-	orders = keeper.GetOrdersFiltered(ctx, nil, "", "", 0)
+	orders = keeper.GetOrdersFiltered(ctx, nil, "", "", 0, 0)
 
 	return GenesisState{
 		StartingOrderId: startingOrderId,
+		Params:          keeper.GetParams(ctx),
 		Orders:          orders,
+		Pools:           keeper.GetPools(ctx),
+		Trades:          keeper.GetAllTrades(ctx),
+		NextTradeId:     keeper.PeekNextTradeId(ctx),
 	}
 }
@@ -4,6 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
 func TestEqualOrderID(t *testing.T) {
@@ -20,3 +25,45 @@ func TestEqualOrderID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestExportImportTrades(t *testing.T) {
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	acc2 := auth.NewBaseAccountWithAddress(Addrs[1])
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1000)))
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, GenesisState{}, []auth.Account{&acc1, &acc2})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	_, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	require.NoError(t, err)
+	_, fills, err := keeper.CreateOrder(ctx, acc2.Address, sdk.NewInt64Coin("foocoin", 200), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), TimeInForce{})
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+
+	genState := ExportGenesis(ctx, keeper)
+	require.Nil(t, ValidateGenesis(genState))
+	require.Len(t, genState.Trades, 1)
+	require.Equal(t, fills[0].TradeId+1, genState.NextTradeId)
+
+	mapp2, keeper2, _, _, _, _ := getMockApp(t, 0, genState, nil)
+
+	header = abci.Header{Height: mapp2.LastBlockHeight() + 1}
+	mapp2.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx2 := mapp2.BaseApp.NewContext(false, abci.Header{})
+
+	trades := keeper2.GetTrades(ctx2, sdk.DefaultBondDenom, "foocoin", 10)
+	require.Len(t, trades, 1)
+	require.Equal(t, fills[0].TradeId, trades[0].TradeId)
+	require.Equal(t, fills[0].Supply, trades[0].Supply)
+	require.Equal(t, fills[0].Target, trades[0].Target)
+	require.Equal(t, genState.NextTradeId, keeper2.PeekNextTradeId(ctx2))
+
+	genState.NextTradeId = fills[0].TradeId
+	require.Error(t, ValidateGenesis(genState))
+}
@@ -12,6 +12,9 @@ import (
 
 func NewHandler(keeper keeper.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		if err := keeper.CheckStoreVersion(ctx); err != nil {
+			return err.Result()
+		}
 		switch msg := msg.(type) {
 		case msgs.MsgCreateOrder:
 			return handlers.HandleMsgCreateOrder(ctx, keeper, msg)
@@ -19,6 +22,14 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 			return handlers.HandleMsgTakeOrder(ctx, keeper, msg)
 		case msgs.MsgWithdrawalOrder:
 			return handlers.HandleMsgWithdrawalOrder(ctx, keeper, msg)
+		case msgs.MsgCreatePool:
+			return handlers.HandleMsgCreatePool(ctx, keeper, msg)
+		case msgs.MsgAddLiquidity:
+			return handlers.HandleMsgAddLiquidity(ctx, keeper, msg)
+		case msgs.MsgRemoveLiquidity:
+			return handlers.HandleMsgRemoveLiquidity(ctx, keeper, msg)
+		case msgs.MsgSwap:
+			return handlers.HandleMsgSwap(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized exchange msg type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
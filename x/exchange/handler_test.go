@@ -17,6 +17,6 @@ func TestHandlerNewMsgCreateOrder(t *testing.T) {
 
 	handler := NewHandler(keeper)
 
-	res := handler(ctx, NewMsgCreateOrder(addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("foocoin", 100)))
+	res := handler(ctx, NewMsgCreateOrder(addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("foocoin", 100), TimeInForce{}))
 	require.True(t, res.IsOK())
 }
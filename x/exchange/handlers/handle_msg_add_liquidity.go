@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+	"github.com/hashgard/hashgard/x/exchange/msgs"
+	"github.com/hashgard/hashgard/x/exchange/tags"
+)
+
+func HandleMsgAddLiquidity(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgAddLiquidity) sdk.Result {
+	_, deposit, shares, err := keeper.AddLiquidity(ctx, msg.Sender, msg.PoolId, msg.MaxDeposit, msg.MinShares)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Category, tags.TxCategory,
+			tags.PoolId, fmt.Sprintf("%d", msg.PoolId),
+			tags.Sender, msg.Sender.String(),
+			tags.Deposit, deposit.String(),
+			tags.Shares, shares.String(),
+		),
+	}
+}
@@ -10,19 +10,34 @@ import (
 )
 
 func HandleMsgCreateOrder(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgCreateOrder) sdk.Result {
-	order, err := keeper.CreateOrder(ctx, msg.Seller, msg.Supply, msg.Target)
+	order, fills, err := keeper.CreateOrder(ctx, msg.Seller, msg.Supply, msg.Target, msg.TimeInForce)
 
 	if err != nil {
 		return err.Result()
 	}
 
+	status := "inactive"
+	if keeper.HasOrder(ctx, order.OrderId) {
+		status = "active"
+	}
+
 	resTags := sdk.NewTags(
 		tags.Category, tags.TxCategory,
 		tags.OrderId, fmt.Sprintf("%d", order.OrderId),
 		tags.Sender, order.Seller.String(),
 		tags.SupplyToken, order.Supply.Denom,
 		tags.TargetToken, order.Target.Denom,
+		tags.OrderStatus, status,
 	)
+	for _, fill := range fills {
+		resTags = resTags.AppendTags(sdk.NewTags(
+			tags.FillOrderId, fmt.Sprintf("%d", fill.OrderId),
+			tags.FillSupply, fill.Supply.String(),
+			tags.FillTarget, fill.Target.String(),
+			tags.MakerFee, fill.MakerFee.String(),
+			tags.TakerFee, fill.TakerFee.String(),
+		))
+	}
 
 	return sdk.Result{
 		Tags: resTags,
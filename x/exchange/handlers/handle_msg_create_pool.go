@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+	"github.com/hashgard/hashgard/x/exchange/msgs"
+	"github.com/hashgard/hashgard/x/exchange/tags"
+)
+
+func HandleMsgCreatePool(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgCreatePool) sdk.Result {
+	pool, shares, err := keeper.CreatePool(ctx, msg.Sender, msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Category, tags.TxCategory,
+			tags.PoolId, fmt.Sprintf("%d", pool.PoolId),
+			tags.Sender, msg.Sender.String(),
+			tags.Deposit, msg.Deposit.String(),
+			tags.Shares, shares.String(),
+		),
+	}
+}
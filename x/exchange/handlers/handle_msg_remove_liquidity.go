@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+	"github.com/hashgard/hashgard/x/exchange/msgs"
+	"github.com/hashgard/hashgard/x/exchange/tags"
+)
+
+func HandleMsgRemoveLiquidity(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgRemoveLiquidity) sdk.Result {
+	pool, withdraw, err := keeper.RemoveLiquidity(ctx, msg.Sender, msg.PoolId, msg.Shares, msg.MinWithdraw)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Category, tags.TxCategory,
+			tags.PoolId, fmt.Sprintf("%d", msg.PoolId),
+			tags.Sender, msg.Sender.String(),
+			tags.Withdraw, withdraw.String(),
+			tags.Shares, sdk.NewCoin(pool.ShareDenom, msg.Shares).String(),
+		),
+	}
+}
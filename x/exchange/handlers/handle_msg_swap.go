@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+	"github.com/hashgard/hashgard/x/exchange/msgs"
+	"github.com/hashgard/hashgard/x/exchange/tags"
+)
+
+func HandleMsgSwap(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgSwap) sdk.Result {
+	_, quote, err := keeper.Swap(ctx, msg.Sender, msg.PoolId, msg.Input, msg.MinOutput)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Category, tags.TxCategory,
+			tags.PoolId, fmt.Sprintf("%d", msg.PoolId),
+			tags.Sender, msg.Sender.String(),
+			tags.SwapInput, quote.Input.String(),
+			tags.SwapOutput, quote.Output.String(),
+			tags.PoolFee, quote.Fee.String(),
+		),
+	}
+}
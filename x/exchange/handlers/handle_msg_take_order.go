@@ -11,7 +11,7 @@ import (
 )
 
 func HandleMsgTakeOrder(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgTakeOrder) sdk.Result {
-	_, _, soldOut, err := keeper.TakeOrder(ctx, msg.OrderId, msg.Buyer, msg.Value)
+	fill, soldOut, err := keeper.TakeOrder(ctx, msg.OrderId, msg.Buyer, msg.Value)
 	if err != nil {
 		return err.Result()
 	}
@@ -29,6 +29,8 @@ func HandleMsgTakeOrder(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgTakeO
 		tags.OrderId, fmt.Sprintf("%d", msg.OrderId),
 		tags.Sender, msg.Buyer.String(),
 		tags.OrderStatus, status,
+		tags.MakerFee, fill.MakerFee.String(),
+		tags.TakerFee, fill.TakerFee.String(),
 	)
 
 	return sdk.Result{
@@ -0,0 +1,57 @@
+package exchange
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+// expected crisis keeper
+type CrisisKeeper interface {
+	RegisterRoute(moduleName, route string, invar sdk.Invariant)
+}
+
+// register exchange invariants
+func RegisterInvariants(c CrisisKeeper, keeper keeper.Keeper) {
+	c.RegisterRoute(types.ModuleName, "frozen-coins", FrozenCoinsInvariant(keeper))
+	c.RegisterRoute(types.ModuleName, "pool-reserves", PoolReservesInvariant(keeper))
+}
+
+// FrozenCoinsInvariant checks that the frozen coins account holds at least the remains of all orders,
+// anybody can send coins to the account so it may hold more
+func FrozenCoinsInvariant(keeper keeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		remains := sdk.Coins{}
+		for _, order := range keeper.GetOrdersFiltered(ctx, nil, "", "", 0, 0) {
+			remains = remains.Add(sdk.NewCoins(order.Remains))
+		}
+		frozen := keeper.GetFrozenCoins(ctx)
+		for _, coin := range remains {
+			if frozen.AmountOf(coin.Denom).LT(coin.Amount) {
+				return fmt.Errorf("orders remain %s but the frozen coins account holds %s", remains, frozen)
+			}
+		}
+		return nil
+	}
+}
+
+// PoolReservesInvariant checks that the pool reserves account holds at least the reserves of all pools,
+// anybody can send coins to the account so it may hold more
+func PoolReservesInvariant(keeper keeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		reserves := sdk.Coins{}
+		for _, pool := range keeper.GetPools(ctx) {
+			reserves = reserves.Add(pool.Reserves())
+		}
+		held := keeper.GetPoolReserves(ctx)
+		for _, coin := range reserves {
+			if held.AmountOf(coin.Denom).LT(coin.Amount) {
+				return fmt.Errorf("pools reserve %s but the pool reserves account holds %s", reserves, held)
+			}
+		}
+		return nil
+	}
+}
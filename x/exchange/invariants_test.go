@@ -0,0 +1,36 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFrozenCoinsInvariant(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	order, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	require.NoError(t, err)
+
+	invariant := FrozenCoinsInvariant(keeper)
+	require.Nil(t, invariant(ctx))
+
+	// coins sent to the frozen coins account by anybody do not break it
+	frozen := mapp.AccountKeeper.GetAccount(ctx, FrozenCoinsAccAddr)
+	require.NoError(t, frozen.SetCoins(frozen.GetCoins().Add(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))))
+	mapp.AccountKeeper.SetAccount(ctx, frozen)
+	require.Nil(t, invariant(ctx))
+
+	order.Remains = sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)
+	keeper.SetOrder(ctx, order)
+	require.Error(t, invariant(ctx))
+}
@@ -1,8 +1,8 @@
 package keeper
 
 import (
-	"bytes"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +17,12 @@ var (
 
 	// TODO: Find another way to implement this without using accounts, or find a cleaner way to implement it using accounts.
 	FrozenCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("exchangeFrozenCoins")))
+
+	// Collects the trading fees unless a treasury is set in the params
+	TreasuryAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("exchangeTreasury")))
+
+	// Holds the reserves of all liquidity pools
+	PoolReservesAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("exchangePoolReserves")))
 )
 
 func ParamKeyTable() params.KeyTable {
@@ -34,6 +40,11 @@ type Keeper struct {
 	codespace    sdk.CodespaceType
 }
 
+// Reports whether an address is one of the accounts holding coins for the exchange
+func IsModuleAccount(_ sdk.Context, addr sdk.AccAddress) bool {
+	return addr.Equals(FrozenCoinsAccAddr) || addr.Equals(PoolReservesAccAddr) || addr.Equals(TreasuryAccAddr)
+}
+
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
 	paramSpace params.Subspace, bankKeeper types.BankKeeper, codespace sdk.CodespaceType) Keeper {
 	return Keeper{
@@ -47,7 +58,31 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
 }
 
 func (keeper Keeper) CreateOrder(ctx sdk.Context, seller sdk.AccAddress,
-	supply sdk.Coin, target sdk.Coin) (order types.Order, err sdk.Error) {
+	supply sdk.Coin, target sdk.Coin, tif types.TimeInForce) (order types.Order, fills []types.Fill, err sdk.Error) {
+	if tif.GetType() != types.FOK {
+		return keeper.createOrder(ctx, seller, supply, target, tif)
+	}
+
+	// a fill or kill order only changes the state when it is filled completely
+	cacheCtx, write := ctx.CacheContext()
+	order, fills, err = keeper.createOrder(cacheCtx, seller, supply, target, tif)
+	if err != nil {
+		return
+	}
+	if !remainShares(order).IsZero() {
+		return order, nil, sdk.NewError(keeper.codespace, types.CodeNotFilled, fmt.Sprintf("order can not be filled completely, remains %s", order.Remains))
+	}
+	write()
+
+	return order, fills, nil
+}
+
+func (keeper Keeper) createOrder(ctx sdk.Context, seller sdk.AccAddress,
+	supply sdk.Coin, target sdk.Coin, tif types.TimeInForce) (order types.Order, fills []types.Fill, err sdk.Error) {
+	if isExpired(ctx, tif) {
+		return order, fills, sdk.NewError(keeper.codespace, types.CodeInvalidInput, fmt.Sprintf("order has expired: %s", tif))
+	}
+
 	orderId, err := keeper.getNewOrderId(ctx)
 	if err != nil {
 		return
@@ -56,12 +91,13 @@ func (keeper Keeper) CreateOrder(ctx sdk.Context, seller sdk.AccAddress,
 	createTime := ctx.BlockHeader().Time
 
 	order = types.Order{
-		OrderId:    orderId,
-		Seller:     seller,
-		Supply:     supply,
-		Target:     target,
-		Remains:    supply,
-		CreateTime: createTime,
+		OrderId:     orderId,
+		Seller:      seller,
+		Supply:      supply,
+		Target:      target,
+		Remains:     supply,
+		CreateTime:  createTime,
+		TimeInForce: tif,
 	}
 
 	err = keeper.bankKeeper.SendCoins(ctx, seller, FrozenCoinsAccAddr, []sdk.Coin{supply})
@@ -69,12 +105,33 @@ func (keeper Keeper) CreateOrder(ctx sdk.Context, seller sdk.AccAddress,
 		return
 	}
 
+	order, fills, err = keeper.matchOrder(ctx, order)
+	if err != nil {
+		return
+	}
+
+	// the remains can not buy a single share of the order or must not rest on the book, so it is returned
+	if remainShares(order).IsZero() || tif.GetType() == types.IOC || tif.GetType() == types.FOK {
+		if order.Remains.IsPositive() {
+			err = keeper.bankKeeper.SendCoins(ctx, FrozenCoinsAccAddr, seller, []sdk.Coin{order.Remains})
+		}
+		return
+	}
+
 	keeper.setOrder(ctx, order)
-	orderIdArr := keeper.GetAddressOrders(ctx, seller)
-	orderIdArr = append(orderIdArr, orderId)
-	keeper.setAddressOrders(ctx, seller, orderIdArr)
 
-	return order, nil
+	return order, fills, nil
+}
+
+// Checks whether a GTT order is due at the current block
+func isExpired(ctx sdk.Context, tif types.TimeInForce) bool {
+	if tif.GetType() != types.GTT {
+		return false
+	}
+	if tif.ExpireTime > 0 && ctx.BlockHeader().Time.Unix() >= tif.ExpireTime {
+		return true
+	}
+	return tif.ExpireHeight > 0 && ctx.BlockHeight() >= tif.ExpireHeight
 }
 
 func (keeper Keeper) WithdrawalOrder(ctx sdk.Context, orderId uint64, addr sdk.AccAddress) (amt sdk.Coin, err sdk.Error) {
@@ -93,46 +150,67 @@ func (keeper Keeper) WithdrawalOrder(ctx sdk.Context, orderId uint64, addr sdk.A
 		return
 	}
 
-	keeper.deleteOrder(ctx, orderId)
+	keeper.deleteOrder(ctx, order)
 
-	orderIdArr := keeper.GetAddressOrders(ctx, addr)
-	for index := 0; index < len(orderIdArr); {
-		if orderIdArr[index] == orderId {
-			orderIdArr = append(orderIdArr[:index], orderIdArr[index+1:]...)
-			break
+	return amt, nil
+}
+
+// Returns the remains of an expired order to the seller
+func (keeper Keeper) ExpireOrder(ctx sdk.Context, order types.Order) (amt sdk.Coin, err sdk.Error) {
+	amt = order.Remains
+	if amt.IsPositive() {
+		err = keeper.bankKeeper.SendCoins(ctx, FrozenCoinsAccAddr, order.Seller, []sdk.Coin{amt})
+		if err != nil {
+			return
 		}
-		index++
 	}
 
-	if len(orderIdArr) == 0 {
-		keeper.deleteAddressOrders(ctx, addr)
-	} else {
-		keeper.setAddressOrders(ctx, addr, orderIdArr)
-	}
+	keeper.deleteOrder(ctx, order)
 
 	return amt, nil
 }
 
-func (keeper Keeper) TakeOrder(ctx sdk.Context, orderId uint64, buyer sdk.AccAddress, val sdk.Coin) (supplyTurnover sdk.Coin,
-	targetTurnover sdk.Coin, soldOut bool, err sdk.Error) {
+// Takes an order whose remains can not be returned out of the book and the expiry queues,
+// it stays frozen until the seller withdraws it
+func (keeper Keeper) FailExpireOrder(ctx sdk.Context, order types.Order) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyBookOrder(order.Supply.Denom, order.Target.Denom, order.OrderId))
+	store.Delete(KeyPriceBookOrder(order.Supply, order.Target, order.OrderId))
+	store.Delete(KeyExpireTimeQueue(order.TimeInForce.ExpireTime, order.OrderId))
+	store.Delete(KeyExpireHeightQueue(order.TimeInForce.ExpireHeight, order.OrderId))
+}
+
+func (keeper Keeper) TakeOrder(ctx sdk.Context, orderId uint64, buyer sdk.AccAddress, val sdk.Coin) (fill types.Fill, soldOut bool, err sdk.Error) {
 	order, ok := keeper.GetOrder(ctx, orderId)
 	if !ok {
-		return supplyTurnover, targetTurnover, soldOut, sdk.NewError(keeper.codespace, types.CodeOrderNotExist, fmt.Sprintf("this orderId is invalid : %d", orderId))
+		return fill, soldOut, sdk.NewError(keeper.codespace, types.CodeOrderNotExist, fmt.Sprintf("this orderId is invalid : %d", orderId))
 	}
 
 	if val.Denom != order.Target.Denom {
-		return supplyTurnover, targetTurnover, soldOut, sdk.NewError(keeper.codespace, types.CodeNotMatchTarget, fmt.Sprintf("%s doesn't match order's target(%s)", val.Denom, order.Target.Denom))
+		return fill, soldOut, sdk.NewError(keeper.codespace, types.CodeNotMatchTarget, fmt.Sprintf("%s doesn't match order's target(%s)", val.Denom, order.Target.Denom))
 	}
 
 	divisor := GetGratestDivisor(order.Supply.Amount, order.Target.Amount)
-	supplyPrice := order.Supply.Amount.Quo(divisor)
-	remainShares := order.Remains.Amount.Quo(supplyPrice)
 	sharePrice := order.Target.Amount.Quo(divisor)
 
 	if val.Amount.LT(sharePrice) {
-		return supplyTurnover, targetTurnover, soldOut, sdk.NewError(keeper.codespace, types.CodeTooLess, fmt.Sprintf("minimum purchase threshold is %s%s", sharePrice.String(), order.Target.Denom))
+		return fill, soldOut, sdk.NewError(keeper.codespace, types.CodeTooLess, fmt.Sprintf("minimum purchase threshold is %s%s", sharePrice.String(), order.Target.Denom))
 	}
 
+	return keeper.fillOrder(ctx, order, 0, buyer, buyer, val)
+}
+
+// Fills the order with at most val of its target paid by payer, the supply turnover is sent to buyer.
+// The seller of the order pays the maker fee out of the target turnover and the buyer pays the taker fee
+// out of the supply turnover. Every fill is recorded in the trade history
+func (keeper Keeper) fillOrder(ctx sdk.Context, order types.Order, takerOrderId uint64, payer sdk.AccAddress, buyer sdk.AccAddress,
+	val sdk.Coin) (fill types.Fill,
+	soldOut bool, err sdk.Error) {
+	divisor := GetGratestDivisor(order.Supply.Amount, order.Target.Amount)
+	supplyPrice := order.Supply.Amount.Quo(divisor)
+	sharePrice := order.Target.Amount.Quo(divisor)
+	remainShares := order.Remains.Amount.Quo(supplyPrice)
+
 	shares := val.Amount.Quo(sharePrice)
 
 	if shares.GTE(remainShares) {
@@ -140,47 +218,61 @@ func (keeper Keeper) TakeOrder(ctx sdk.Context, orderId uint64, buyer sdk.AccAdd
 		soldOut = true
 	}
 
-	supplyTurnover = sdk.NewCoin(order.Supply.Denom, order.Supply.Amount.Quo(divisor).Mul(shares))
-	targetTurnover = sdk.NewCoin(order.Target.Denom, sharePrice.Mul(shares))
-
-	err = keeper.bankKeeper.SendCoins(ctx, buyer, order.Seller, []sdk.Coin{targetTurnover})
-	if err != nil {
+	supplyTurnover := sdk.NewCoin(order.Supply.Denom, supplyPrice.Mul(shares))
+	targetTurnover := sdk.NewCoin(order.Target.Denom, sharePrice.Mul(shares))
+	makerFeeRate, takerFeeRate := keeper.GetParams(ctx).GetFeeRates(order.Supply.Denom, order.Target.Denom)
+	makerFee := types.CalcFee(order.Target.Denom, targetTurnover.Amount, makerFeeRate)
+	takerFee := types.CalcFee(order.Supply.Denom, supplyTurnover.Amount, takerFeeRate)
+	fill = types.NewFill(order.OrderId, takerOrderId, buyer, order.Seller, supplyTurnover, targetTurnover, makerFee, takerFee)
+	if shares.IsZero() && !soldOut {
 		return
 	}
-	err = keeper.bankKeeper.SendCoins(ctx, FrozenCoinsAccAddr, buyer, []sdk.Coin{supplyTurnover})
-	if err != nil {
-		return
+
+	if targetTurnover.IsPositive() {
+		err = keeper.bankKeeper.SendCoins(ctx, payer, order.Seller, sdk.NewCoins(targetTurnover.Sub(makerFee)))
+		if err != nil {
+			return
+		}
+		err = keeper.bankKeeper.SendCoins(ctx, FrozenCoinsAccAddr, buyer, sdk.NewCoins(supplyTurnover.Sub(takerFee)))
+		if err != nil {
+			return
+		}
+		err = keeper.collectFee(ctx, payer, makerFee)
+		if err != nil {
+			return
+		}
+		err = keeper.collectFee(ctx, FrozenCoinsAccAddr, takerFee)
+		if err != nil {
+			return
+		}
+
+		fill = keeper.addTrade(ctx, fill)
 	}
 
+	remains := order.Remains.Sub(supplyTurnover)
 	if soldOut {
-		keeper.deleteOrder(ctx, orderId)
-		orderIdArr := keeper.GetAddressOrders(ctx, order.Seller)
-		for index := 0; index < len(orderIdArr); {
-			if orderIdArr[index] == orderId {
-				orderIdArr = append(orderIdArr[:index], orderIdArr[index+1:]...)
-				break
+		// return the part that is less than one share to the seller
+		if remains.IsPositive() {
+			err = keeper.bankKeeper.SendCoins(ctx, FrozenCoinsAccAddr, order.Seller, []sdk.Coin{remains})
+			if err != nil {
+				return
 			}
-			index++
-		}
-		if len(orderIdArr) == 0 {
-			keeper.deleteAddressOrders(ctx, order.Seller)
-		} else {
-			keeper.setAddressOrders(ctx, order.Seller, orderIdArr)
 		}
+		keeper.deleteOrder(ctx, order)
 	} else {
-		remains := order.Remains.Sub(supplyTurnover)
-		newOrder := types.Order{
-			OrderId:    orderId,
-			Seller:     order.Seller,
-			Supply:     order.Supply,
-			Target:     order.Target,
-			Remains:    remains,
-			CreateTime: order.CreateTime,
-		}
-		keeper.setOrder(ctx, newOrder)
+		order.Remains = remains
+		keeper.setOrder(ctx, order)
 	}
 
-	return supplyTurnover, targetTurnover, soldOut, nil
+	return fill, soldOut, nil
+}
+
+// Sends a trading fee to the exchange treasury
+func (keeper Keeper) collectFee(ctx sdk.Context, from sdk.AccAddress, fee sdk.Coin) sdk.Error {
+	if !fee.IsPositive() {
+		return nil
+	}
+	return keeper.bankKeeper.SendCoins(ctx, from, keeper.GetTreasury(ctx), []sdk.Coin{fee})
 }
 
 func (keeper Keeper) GetOrdersByAddr(ctx sdk.Context, addr sdk.AccAddress) (orders types.Orders, err sdk.Error) {
@@ -209,6 +301,32 @@ func (keeper Keeper) GetFrozenFundByAddr(ctx sdk.Context, addr sdk.AccAddress) (
 	return fund, nil
 }
 
+// Returns the coins held by the frozen coins account of all orders
+func (keeper Keeper) GetFrozenCoins(ctx sdk.Context) sdk.Coins {
+	return keeper.bankKeeper.GetCoins(ctx, FrozenCoinsAccAddr)
+}
+
+// Returns the current exchange params from the global param store
+func (keeper Keeper) GetParams(ctx sdk.Context) types.ExchangeParams {
+	var exchangeParams types.ExchangeParams
+	keeper.paramSpace.GetIfExists(ctx, ParamsStoreKeyExchangeParams, &exchangeParams)
+	return exchangeParams
+}
+
+// Set exchange params
+func (keeper Keeper) SetParams(ctx sdk.Context, exchangeParams types.ExchangeParams) {
+	keeper.paramSpace.Set(ctx, ParamsStoreKeyExchangeParams, &exchangeParams)
+}
+
+// Returns the account which receives the trading fees
+func (keeper Keeper) GetTreasury(ctx sdk.Context) sdk.AccAddress {
+	treasury := keeper.GetParams(ctx).Treasury
+	if treasury.Empty() {
+		return TreasuryAccAddr
+	}
+	return treasury
+}
+
 // Store level
 func (keeper Keeper) GetOrder(ctx sdk.Context, orderId uint64) (types.Order, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -225,15 +343,37 @@ func (keeper Keeper) setOrder(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(keeper.storeKey)
 	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(order)
 	store.Set(KeyOrder(order.OrderId), bz)
+	bz = keeper.cdc.MustMarshalBinaryLengthPrefixed(order.OrderId)
+	store.Set(KeySellerOrder(order.Seller, order.OrderId), bz)
+	store.Set(KeySupplyOrder(order.Supply.Denom, order.OrderId), bz)
+	store.Set(KeyTargetOrder(order.Target.Denom, order.OrderId), bz)
+	store.Set(KeyBookOrder(order.Supply.Denom, order.Target.Denom, order.OrderId), bz)
+	store.Set(KeyPriceBookOrder(order.Supply, order.Target, order.OrderId), bz)
+	if order.TimeInForce.GetType() != types.GTT {
+		return
+	}
+	if order.TimeInForce.ExpireTime > 0 {
+		store.Set(KeyExpireTimeQueue(order.TimeInForce.ExpireTime, order.OrderId), bz)
+	}
+	if order.TimeInForce.ExpireHeight > 0 {
+		store.Set(KeyExpireHeightQueue(order.TimeInForce.ExpireHeight, order.OrderId), bz)
+	}
 }
 
 func (keeper Keeper) SetOrder(ctx sdk.Context, order types.Order) {
 	keeper.setOrder(ctx, order)
 }
 
-func (keeper Keeper) deleteOrder(ctx sdk.Context, orderId uint64) {
+func (keeper Keeper) deleteOrder(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(KeyOrder(orderId))
+	store.Delete(KeyOrder(order.OrderId))
+	store.Delete(KeySellerOrder(order.Seller, order.OrderId))
+	store.Delete(KeySupplyOrder(order.Supply.Denom, order.OrderId))
+	store.Delete(KeyTargetOrder(order.Target.Denom, order.OrderId))
+	store.Delete(KeyBookOrder(order.Supply.Denom, order.Target.Denom, order.OrderId))
+	store.Delete(KeyPriceBookOrder(order.Supply, order.Target, order.OrderId))
+	store.Delete(KeyExpireTimeQueue(order.TimeInForce.ExpireTime, order.OrderId))
+	store.Delete(KeyExpireHeightQueue(order.TimeInForce.ExpireHeight, order.OrderId))
 }
 
 func (keeper Keeper) HasOrder(ctx sdk.Context, orderId uint64) bool {
@@ -241,6 +381,29 @@ func (keeper Keeper) HasOrder(ctx sdk.Context, orderId uint64) bool {
 	return store.Has(KeyOrder(orderId))
 }
 
+// Returns the ids of the orders which expire by the block time or height, at most limit of them
+func (keeper Keeper) GetExpiredOrderIds(ctx sdk.Context, limit int) (orderIds []uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	timeIterator := store.Iterator(PrefixExpireTimeQueue(), sdk.PrefixEndBytes(PrefixExpireTime(ctx.BlockHeader().Time.Unix())))
+	defer timeIterator.Close()
+	for ; timeIterator.Valid() && len(orderIds) < limit; timeIterator.Next() {
+		var orderId uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(timeIterator.Value(), &orderId)
+		orderIds = append(orderIds, orderId)
+	}
+
+	heightIterator := store.Iterator(PrefixExpireHeightQueue(), sdk.PrefixEndBytes(PrefixExpireHeight(ctx.BlockHeight())))
+	defer heightIterator.Close()
+	for ; heightIterator.Valid() && len(orderIds) < limit; heightIterator.Next() {
+		var orderId uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(heightIterator.Value(), &orderId)
+		orderIds = append(orderIds, orderId)
+	}
+
+	return orderIds
+}
+
 // Get the next available OrderId and increments it
 func (keeper Keeper) getNewOrderId(ctx sdk.Context) (orderId uint64, err sdk.Error) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -277,74 +440,70 @@ func (keeper Keeper) SetInitialOrderId(ctx sdk.Context, orderId uint64) sdk.Erro
 	return nil
 }
 
+// Returns the ids of the orders of a seller from the seller index
 func (keeper Keeper) GetAddressOrders(ctx sdk.Context, addr sdk.AccAddress) (orderIdArr []uint64) {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := store.Get(KeyAddressOrders(addr))
-	if bz == nil {
-		return []uint64{}
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeySellerOrders(addr))
+	defer iterator.Close()
+
+	orderIdArr = []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		var orderId uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &orderId)
+		orderIdArr = append(orderIdArr, orderId)
 	}
-
-	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &orderIdArr)
 	return orderIdArr
 }
 
-func (keeper Keeper) setAddressOrders(ctx sdk.Context, addr sdk.AccAddress, orderIdArr []uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(orderIdArr)
-	store.Set(KeyAddressOrders(addr), bz)
-}
-
-func (keeper Keeper) SetAddressOrders(ctx sdk.Context, addr sdk.AccAddress, orderIdArr []uint64) {
-	keeper.setAddressOrders(ctx, addr, orderIdArr)
-}
-
-func (keeper Keeper) deleteAddressOrders(ctx sdk.Context, addr sdk.AccAddress) {
-	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(KeyAddressOrders(addr))
-}
-
-// Get Order from store by OrderId
+// Get Orders from store in the order of OrderId
 // seller will filter orders by creator
 // supplyDenom will filter orders by supply token denom
 // targetDenom will filter orders by target token denom
-// numLatest will fetch a specified number of the most recent orders, or 0 for all orders
-func (keeper Keeper) GetOrdersFiltered(ctx sdk.Context,
-	seller sdk.AccAddress, supplyDenom string, targetDenom string, numLatest uint64) []types.Order {
-
-	maxOrderId, err := keeper.PeekCurrentOrderId(ctx)
-	if err != nil {
-		return nil
+// startOrderId is the cursor of the page, the orders begin with it
+// limit will fetch a specified number of orders, or 0 for all orders
+// The most selective index of the filters is iterated, so only the orders it covers are read
+func (keeper Keeper) GetOrdersFiltered(ctx sdk.Context, seller sdk.AccAddress, supplyDenom string, targetDenom string,
+	startOrderId uint64, limit int) []types.Order {
+	prefix := PrefixKeyOrders()
+	switch {
+	case !seller.Empty():
+		prefix = PrefixKeySellerOrders(seller)
+	case supplyDenom != "" && targetDenom != "":
+		prefix = PrefixKeyBook(supplyDenom, targetDenom)
+	case supplyDenom != "":
+		prefix = PrefixKeySupplyOrders(supplyDenom)
+	case targetDenom != "":
+		prefix = PrefixKeyTargetOrders(targetDenom)
 	}
 
-	matchOrders := []types.Order{}
-
-	if numLatest == 0 {
-		numLatest = maxOrderId
-	}
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(append(prefix, keyOrderId(startOrderId)...), sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
 
-	for orderId := maxOrderId - numLatest; orderId < maxOrderId; orderId++ {
+	matchOrders := []types.Order{}
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && len(matchOrders) >= limit {
+			break
+		}
 
+		key := iterator.Key()
+		orderId, err := strconv.ParseUint(string(key[len(prefix):]), 10, 64)
+		if err != nil {
+			continue
+		}
 		order, ok := keeper.GetOrder(ctx, orderId)
 		if !ok {
 			continue
 		}
 
-		if seller != nil && len(seller) != 0 {
-			if !bytes.Equal(order.Seller, seller) {
-				continue
-			}
+		if !seller.Empty() && !order.Seller.Equals(seller) {
+			continue
 		}
-
-		if supplyDenom != "" {
-			if order.Supply.Denom != supplyDenom {
-				continue
-			}
+		if supplyDenom != "" && order.Supply.Denom != supplyDenom {
+			continue
 		}
-
-		if targetDenom != "" {
-			if order.Target.Denom != targetDenom {
-				continue
-			}
+		if targetDenom != "" && order.Target.Denom != targetDenom {
+			continue
 		}
 
 		matchOrders = append(matchOrders, order)
@@ -352,3 +511,20 @@ func (keeper Keeper) GetOrdersFiltered(ctx sdk.Context,
 
 	return matchOrders
 }
+
+// Returns the version of the layout of the exchange store
+func (keeper Keeper) GetStoreVersion(ctx sdk.Context) (version uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyStoreVersion)
+	if bz == nil {
+		return 0
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &version)
+	return version
+}
+
+// Set the version of the layout of the exchange store
+func (keeper Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyStoreVersion, keeper.cdc.MustMarshalBinaryLengthPrefixed(version))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -10,14 +11,170 @@ var (
 	KeyDelimiter = []byte(":")
 
 	KeyNextOrderId = []byte("newOrderId")
+
+	KeyNextTradeId = []byte("newTradeId")
+
+	KeyStoreVersion = []byte("storeVersion")
+
+	// the next order key to migrate, absent while the legacy indexes are deleted
+	KeyMigrationCursor = []byte("migrationCursor")
+
+	KeyNextPoolId = []byte("newPoolId")
+
+	// the prices in the price index keep 18 decimals
+	priceScale = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
 )
 
+// The length of the price part of a price index key, wide enough for the largest amount scaled by 10^18
+const priceKeyLength = 96
+
+// Returns the order id part of an order key or an index key
+func keyOrderId(orderId uint64) []byte {
+	return []byte(fmt.Sprintf("%020d", orderId))
+}
+
+// Returns the prefix of all orders
+func PrefixKeyOrders() []byte {
+	return []byte("orders:")
+}
+
 // Key for getting a specific order from the store
 func KeyOrder(orderId uint64) []byte {
-	return []byte(fmt.Sprintf("orders:%d", orderId))
+	return append(PrefixKeyOrders(), keyOrderId(orderId)...)
+}
+
+// Returns the prefix of the orders stored by a version before the indexes, which kept the order ids
+// of a seller in a single list
+func PrefixKeyLegacyAddressOrders() []byte {
+	return []byte("address:")
+}
+
+// Returns the prefix of the index of the orders of a seller
+func PrefixKeySellerOrders(seller sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("seller:%s:", seller.String()))
+}
+
+// Key for an order in the index of the orders of a seller
+func KeySellerOrder(seller sdk.AccAddress, orderId uint64) []byte {
+	return append(PrefixKeySellerOrders(seller), keyOrderId(orderId)...)
+}
+
+// Returns the prefix of the index of the orders which supply a denom
+func PrefixKeySupplyOrders(supplyDenom string) []byte {
+	return []byte(fmt.Sprintf("supply:%s:", supplyDenom))
+}
+
+// Key for an order in the index of the orders which supply a denom
+func KeySupplyOrder(supplyDenom string, orderId uint64) []byte {
+	return append(PrefixKeySupplyOrders(supplyDenom), keyOrderId(orderId)...)
+}
+
+// Returns the prefix of the index of the orders which target a denom
+func PrefixKeyTargetOrders(targetDenom string) []byte {
+	return []byte(fmt.Sprintf("target:%s:", targetDenom))
+}
+
+// Key for an order in the index of the orders which target a denom
+func KeyTargetOrder(targetDenom string, orderId uint64) []byte {
+	return append(PrefixKeyTargetOrders(targetDenom), keyOrderId(orderId)...)
+}
+
+// Returns the prefix of all order books
+func PrefixKeyBooks() []byte {
+	return []byte("book:")
+}
+
+// Key for getting a specific order from the order book of a pair
+func KeyBookOrder(supplyDenom string, targetDenom string, orderId uint64) []byte {
+	return append(PrefixKeyBook(supplyDenom, targetDenom), keyOrderId(orderId)...)
+}
+
+// Key for getting all orders of a pair from the order book
+func PrefixKeyBook(supplyDenom string, targetDenom string) []byte {
+	return []byte(fmt.Sprintf("book:%s:%s:", supplyDenom, targetDenom))
+}
+
+// Returns the prefix of all price indexes of the order books
+func PrefixKeyPriceBooks() []byte {
+	return []byte("price:")
+}
+
+// Returns the prefix of the price index of the order book of a pair
+func PrefixKeyPriceBook(supplyDenom string, targetDenom string) []byte {
+	return []byte(fmt.Sprintf("price:%s:%s:", supplyDenom, targetDenom))
+}
+
+// Key for an order in the price index of the order book of a pair. The price is the target amount per unit
+// of supply rounded down to 18 decimals and zero padded, so the keys sort by the lowest price first
+func KeyPriceBookOrder(supply sdk.Coin, target sdk.Coin, orderId uint64) []byte {
+	price := new(big.Int).Mul(target.Amount.BigInt(), priceScale)
+	price.Quo(price, supply.Amount.BigInt())
+	return append(PrefixKeyPriceBook(supply.Denom, target.Denom),
+		[]byte(fmt.Sprintf("%0*d:%020d", priceKeyLength, price, orderId))...)
+}
+
+// Returns the prefix of the orders which expire by block time
+func PrefixExpireTimeQueue() []byte {
+	return []byte("expire:time:")
+}
+
+// Returns the prefix of the orders which expire at a block time
+func PrefixExpireTime(expireTime int64) []byte {
+	return []byte(fmt.Sprintf("expire:time:%020d", expireTime))
+}
+
+// Key for an order in the queue of orders which expire by block time
+func KeyExpireTimeQueue(expireTime int64, orderId uint64) []byte {
+	return []byte(fmt.Sprintf("expire:time:%020d:%d", expireTime, orderId))
+}
+
+// Returns the prefix of the orders which expire by block height
+func PrefixExpireHeightQueue() []byte {
+	return []byte("expire:height:")
+}
+
+// Returns the prefix of the orders which expire at a block height
+func PrefixExpireHeight(expireHeight int64) []byte {
+	return []byte(fmt.Sprintf("expire:height:%020d", expireHeight))
+}
+
+// Key for an order in the queue of orders which expire by block height
+func KeyExpireHeightQueue(expireHeight int64, orderId uint64) []byte {
+	return []byte(fmt.Sprintf("expire:height:%020d:%d", expireHeight, orderId))
+}
+
+// Returns the prefix of the trades of all pairs
+func PrefixKeyAllTrades() []byte {
+	return []byte("trades:")
+}
+
+// Returns the prefix of the trades of a pair, the trades in both directions of the pair share the prefix
+func PrefixKeyTrades(denom1 string, denom2 string) []byte {
+	if denom1 > denom2 {
+		denom1, denom2 = denom2, denom1
+	}
+	return []byte(fmt.Sprintf("trades:%s:%s:", denom1, denom2))
+}
+
+// Key for getting a specific trade of a pair from the store
+func KeyTrade(denom1 string, denom2 string, tradeId uint64) []byte {
+	return append(PrefixKeyTrades(denom1, denom2), []byte(fmt.Sprintf("%020d", tradeId))...)
+}
+
+// Returns the prefix of all pools
+func PrefixKeyPools() []byte {
+	return []byte("pools:")
+}
+
+// Key for getting a specific pool from the store
+func KeyPool(poolId uint64) []byte {
+	return append(PrefixKeyPools(), []byte(fmt.Sprintf("%020d", poolId))...)
 }
 
-// Key for getting all orders of a seller from the store
-func KeyAddressOrders(addr sdk.AccAddress) []byte {
-	return []byte(fmt.Sprintf("address:%d", addr))
+// Key for getting the pool id of a pair, both directions of the pair share the key
+func KeyPoolPair(denom1 string, denom2 string) []byte {
+	if denom1 > denom2 {
+		denom1, denom2 = denom2, denom1
+	}
+	return []byte(fmt.Sprintf("poolPair:%s:%s", denom1, denom2))
 }
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+// The version of the store layout with the order indexes by seller, supply denom, target denom and pair
+// and the price index of the order books
+const StoreVersion uint64 = 2
+
+// Rewrites a batch of the orders kept by an older layout of the store with the current keys and indexes.
+// Older layouts keyed the orders by unpadded ids, kept the order ids of a seller in a single list
+// or had no price index of the order books. The legacy indexes are deleted first, then the orders are
+// rewritten from a cursor, at most MaxMigratedKeysPerBlock keys a call.
+// Returns the number of migrated orders and whether the store is up to date
+func (keeper Keeper) MigrateStore(ctx sdk.Context) (migrated int, done bool) {
+	if keeper.GetStoreVersion(ctx) >= StoreVersion {
+		return 0, true
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	cursor := store.Get(KeyMigrationCursor)
+	if cursor == nil {
+		if keeper.deleteLegacyIndexes(ctx, types.MaxMigratedKeysPerBlock) {
+			store.Set(KeyMigrationCursor, PrefixKeyOrders())
+		}
+		return 0, false
+	}
+
+	// the rewritten keys are zero padded and sort before the unpadded ones still to migrate
+	var orders []types.Order
+	var orderKeys [][]byte
+	var next []byte
+	iterator := store.Iterator(cursor, sdk.PrefixEndBytes(PrefixKeyOrders()))
+	for ; iterator.Valid(); iterator.Next() {
+		if len(orders) == types.MaxMigratedKeysPerBlock {
+			next = iterator.Key()
+			break
+		}
+		var order types.Order
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &order)
+		orders = append(orders, order)
+		orderKeys = append(orderKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i, order := range orders {
+		store.Delete(orderKeys[i])
+		keeper.setOrder(ctx, order)
+	}
+
+	if next != nil {
+		store.Set(KeyMigrationCursor, next)
+		return len(orders), false
+	}
+	store.Delete(KeyMigrationCursor)
+	keeper.SetStoreVersion(ctx, StoreVersion)
+	return len(orders), true
+}
+
+// Deletes at most limit keys of the legacy seller lists and of the order books and their price indexes,
+// which are rebuilt with the orders. Returns whether none is left
+func (keeper Keeper) deleteLegacyIndexes(ctx sdk.Context, limit int) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	var legacyKeys [][]byte
+	for _, prefix := range [][]byte{PrefixKeyLegacyAddressOrders(), PrefixKeyBooks(), PrefixKeyPriceBooks()} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid() && len(legacyKeys) < limit; iterator.Next() {
+			legacyKeys = append(legacyKeys, iterator.Key())
+		}
+		iterator.Close()
+	}
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+	return len(legacyKeys) < limit
+}
+
+// Returns an error while the store is migrated to the current layout, the orders are not fully indexed meanwhile
+func (keeper Keeper) CheckStoreVersion(ctx sdk.Context) sdk.Error {
+	if keeper.GetStoreVersion(ctx) < StoreVersion {
+		return sdk.NewError(keeper.codespace, types.CodeMigrating,
+			fmt.Sprintf("the exchange store is migrated to version %d", StoreVersion))
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+// Returns the resting orders which sell supplyDenom for targetDenom,
+// sorted by the lowest price first and the earliest order first within the same price
+func (keeper Keeper) GetBookOrders(ctx sdk.Context, supplyDenom string, targetDenom string) types.Orders {
+	orders := types.Orders{}
+	keeper.iterateBookOrders(ctx, supplyDenom, targetDenom, func(order types.Order) bool {
+		orders = append(orders, order)
+		return false
+	})
+	return orders
+}
+
+// Calls fn with the resting orders which sell supplyDenom for targetDenom in the order of GetBookOrders
+// until fn returns true. The price index is read one price level at a time, so fn may change the book
+func (keeper Keeper) iterateBookOrders(ctx sdk.Context, supplyDenom string, targetDenom string, fn func(order types.Order) (stop bool)) {
+	prefix := PrefixKeyPriceBook(supplyDenom, targetDenom)
+	start := prefix
+	for {
+		orders, next := keeper.getPriceLevelOrders(ctx, prefix, start)
+		for _, order := range orders {
+			if fn(order) {
+				return
+			}
+		}
+		if next == nil {
+			return
+		}
+		start = next
+	}
+}
+
+// Returns the orders of the first price level of the price index from start on and the key of the next level.
+// Prices which only differ beyond 18 decimals share a level, so the orders are sorted by their exact price
+func (keeper Keeper) getPriceLevelOrders(ctx sdk.Context, prefix []byte, start []byte) (orders types.Orders, next []byte) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var level []byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if level == nil {
+			level = append([]byte{}, key[:len(prefix)+priceKeyLength]...)
+		} else if !bytes.HasPrefix(key, level) {
+			break
+		}
+		var orderId uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &orderId)
+		order, ok := keeper.GetOrder(ctx, orderId)
+		if !ok {
+			continue
+		}
+		orders = append(orders, order)
+	}
+	if level == nil {
+		return orders, nil
+	}
+
+	sort.SliceStable(orders, func(i, j int) bool {
+		left := orders[i].Target.Amount.Mul(orders[j].Supply.Amount)
+		right := orders[j].Target.Amount.Mul(orders[i].Supply.Amount)
+		if !left.Equal(right) {
+			return left.LT(right)
+		}
+		return orders[i].OrderId < orders[j].OrderId
+	})
+
+	return orders, sdk.PrefixEndBytes(level)
+}
+
+// Returns the depth of the order book of a pair, prices are in targetDenom per supplyDenom
+// and amounts are in supplyDenom
+func (keeper Keeper) GetDepth(ctx sdk.Context, supplyDenom string, targetDenom string) types.Depth {
+	depth := types.Depth{
+		Asks: []types.PriceLevel{},
+		Bids: []types.PriceLevel{},
+	}
+
+	for _, order := range keeper.GetBookOrders(ctx, supplyDenom, targetDenom) {
+		price := sdk.NewDecFromInt(order.Target.Amount).QuoInt(order.Supply.Amount)
+		depth.Asks = addPriceLevel(depth.Asks, price, order.Remains.Amount)
+	}
+	for _, order := range keeper.GetBookOrders(ctx, targetDenom, supplyDenom) {
+		price := sdk.NewDecFromInt(order.Supply.Amount).QuoInt(order.Target.Amount)
+		amount := order.Remains.Amount.Mul(order.Target.Amount).Quo(order.Supply.Amount)
+		depth.Bids = addPriceLevel(depth.Bids, price, amount)
+	}
+
+	return depth
+}
+
+func addPriceLevel(levels []types.PriceLevel, price sdk.Dec, amount sdk.Int) []types.PriceLevel {
+	last := len(levels) - 1
+	if last >= 0 && levels[last].Price.Equal(price) {
+		levels[last].Amount = levels[last].Amount.Add(amount)
+		levels[last].Orders++
+		return levels
+	}
+	return append(levels, types.NewPriceLevel(price, amount))
+}
+
+// Matches the order against the resting orders of the opposite side, the best price first,
+// and stops at the first resting order which does not cross.
+// Each fill is executed at the price of the resting order and paid from the frozen supply of the order.
+// A resting order whose seller can not receive the payment is cancelled, so it does not block the pair
+func (keeper Keeper) matchOrder(ctx sdk.Context, order types.Order) (types.Order, []types.Fill, sdk.Error) {
+	fills := []types.Fill{}
+
+	var err sdk.Error
+	keeper.iterateBookOrders(ctx, order.Target.Denom, order.Supply.Denom, func(resting types.Order) bool {
+		if !isCrossed(order, resting) {
+			return true
+		}
+
+		val := sdk.NewCoin(resting.Target.Denom, order.Remains.Amount)
+		cacheCtx, write := ctx.CacheContext()
+		fill, _, fillErr := keeper.fillOrder(cacheCtx, resting, order.OrderId, FrozenCoinsAccAddr, order.Seller, val)
+		if fillErr != nil {
+			if keeper.canReceive(ctx, resting.Seller, resting.Target.Denom) {
+				err = fillErr
+				return true
+			}
+			keeper.cancelOrder(ctx, resting)
+			return false
+		}
+		write()
+		if fill.Target.IsZero() {
+			return false
+		}
+
+		order.Remains = order.Remains.Sub(fill.Target)
+		fills = append(fills, fill)
+
+		return remainShares(order).IsZero()
+	})
+
+	return order, fills, err
+}
+
+// Checks whether the frozen coins account can pay a unit of the denom to the address, the store is left unchanged
+func (keeper Keeper) canReceive(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	cacheCtx, _ := ctx.CacheContext()
+	return keeper.bankKeeper.SendCoins(cacheCtx, FrozenCoinsAccAddr, addr, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))) == nil
+}
+
+// Returns the remains of a resting order to its seller and deletes it, if the remains can not be returned
+// the order is only taken out of the book and stays frozen until the seller withdraws it
+func (keeper Keeper) cancelOrder(ctx sdk.Context, order types.Order) {
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := keeper.ExpireOrder(cacheCtx, order); err != nil {
+		keeper.FailExpireOrder(ctx, order)
+		return
+	}
+	write()
+}
+
+// Checks whether the resting order offers at least the price the order asks for
+func isCrossed(order types.Order, resting types.Order) bool {
+	return resting.Supply.Amount.Mul(order.Supply.Amount).GTE(order.Target.Amount.Mul(resting.Target.Amount))
+}
+
+// Returns how many shares of the order can still be sold at its own price
+func remainShares(order types.Order) sdk.Int {
+	divisor := GetGratestDivisor(order.Supply.Amount, order.Target.Amount)
+	return order.Remains.Amount.Quo(order.Supply.Amount.Quo(divisor))
+}
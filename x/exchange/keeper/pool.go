@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+// Creates a constant product pool of the two coins of the deposit and mints the initial shares to the creator
+func (keeper Keeper) CreatePool(ctx sdk.Context, creator sdk.AccAddress, deposit sdk.Coins) (pool types.Pool, shares sdk.Coin, err sdk.Error) {
+	if len(deposit) != 2 || !deposit.IsAllPositive() {
+		return pool, shares, sdk.NewError(keeper.codespace, types.CodeInvalidInput, fmt.Sprintf("a pool needs a deposit of two coins: %s", deposit))
+	}
+	if poolId, ok := keeper.GetPoolIdByDenoms(ctx, deposit[0].Denom, deposit[1].Denom); ok {
+		return pool, shares, sdk.NewError(keeper.codespace, types.CodePoolExist, fmt.Sprintf("pool %d of %s and %s already exists", poolId, deposit[0].Denom, deposit[1].Denom))
+	}
+
+	pool = types.NewPool(keeper.getNewPoolId(ctx), creator, deposit[0].Denom, deposit[1].Denom, ctx.BlockHeader().Time)
+	pool, shares, err = keeper.deposit(ctx, pool, creator, deposit, types.GetInitialShares(deposit[0].Amount, deposit[1].Amount))
+	if err != nil {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyPoolPair(pool.ReserveA.Denom, pool.ReserveB.Denom), keeper.cdc.MustMarshalBinaryLengthPrefixed(pool.PoolId))
+
+	return pool, shares, nil
+}
+
+// Adds liquidity in the ratio of the reserves with at most maxDeposit, fails if less than minShares are minted.
+// An emptied pool takes the whole deposit like a new pool
+func (keeper Keeper) AddLiquidity(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, maxDeposit sdk.Coins,
+	minShares sdk.Int) (pool types.Pool, deposit sdk.Coins, shares sdk.Coin, err sdk.Error) {
+	pool, ok := keeper.GetPool(ctx, poolId)
+	if !ok {
+		return pool, deposit, shares, sdk.NewError(keeper.codespace, types.CodePoolNotExist, fmt.Sprintf("this poolId is invalid : %d", poolId))
+	}
+	maxA := maxDeposit.AmountOf(pool.ReserveA.Denom)
+	maxB := maxDeposit.AmountOf(pool.ReserveB.Denom)
+	if !maxA.IsPositive() || !maxB.IsPositive() {
+		return pool, deposit, shares, sdk.NewError(keeper.codespace, types.CodeInvalidInput, fmt.Sprintf("deposit must contain %s and %s", pool.ReserveA.Denom, pool.ReserveB.Denom))
+	}
+
+	deposit = sdk.NewCoins(sdk.NewCoin(pool.ReserveA.Denom, maxA), sdk.NewCoin(pool.ReserveB.Denom, maxB))
+	amount := types.GetInitialShares(maxA, maxB)
+	if !pool.IsEmpty() {
+		// mint the shares of the scarcer side and round the deposit up in favor of the pool
+		amount = sdk.MinInt(maxA.Mul(pool.TotalShares).Quo(pool.ReserveA.Amount), maxB.Mul(pool.TotalShares).Quo(pool.ReserveB.Amount))
+		deposit = sdk.NewCoins(
+			sdk.NewCoin(pool.ReserveA.Denom, ceilQuo(amount.Mul(pool.ReserveA.Amount), pool.TotalShares)),
+			sdk.NewCoin(pool.ReserveB.Denom, ceilQuo(amount.Mul(pool.ReserveB.Amount), pool.TotalShares)))
+	}
+	if amount.LT(minShares) {
+		return pool, deposit, shares, sdk.NewError(keeper.codespace, types.CodeSlippage, fmt.Sprintf("deposit mints %s shares, less than %s", amount, minShares))
+	}
+
+	pool, shares, err = keeper.deposit(ctx, pool, sender, deposit, amount)
+	if err != nil {
+		return
+	}
+
+	return pool, deposit, shares, nil
+}
+
+// Moves the deposit to the reserves and mints the shares to the sender
+func (keeper Keeper) deposit(ctx sdk.Context, pool types.Pool, sender sdk.AccAddress, deposit sdk.Coins,
+	amount sdk.Int) (types.Pool, sdk.Coin, sdk.Error) {
+	shares := sdk.NewCoin(pool.ShareDenom, amount)
+	if !shares.IsPositive() {
+		return pool, shares, sdk.NewError(keeper.codespace, types.CodeTooLess, fmt.Sprintf("deposit %s is too less to mint a share", deposit))
+	}
+
+	err := keeper.bankKeeper.SendCoins(ctx, sender, PoolReservesAccAddr, deposit)
+	if err != nil {
+		return pool, shares, err
+	}
+	_, err = keeper.bankKeeper.AddCoins(ctx, sender, sdk.NewCoins(shares))
+	if err != nil {
+		return pool, shares, err
+	}
+
+	for _, coin := range deposit {
+		pool = pool.AddReserve(coin)
+	}
+	pool.TotalShares = pool.TotalShares.Add(shares.Amount)
+	keeper.setPool(ctx, pool)
+
+	return pool, shares, nil
+}
+
+// Burns shares of the sender and withdraws their part of the reserves, fails if less than minWithdraw is withdrawn
+func (keeper Keeper) RemoveLiquidity(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shares sdk.Int,
+	minWithdraw sdk.Coins) (pool types.Pool, withdraw sdk.Coins, err sdk.Error) {
+	pool, ok := keeper.GetPool(ctx, poolId)
+	if !ok {
+		return pool, withdraw, sdk.NewError(keeper.codespace, types.CodePoolNotExist, fmt.Sprintf("this poolId is invalid : %d", poolId))
+	}
+	if !shares.IsPositive() || shares.GT(pool.TotalShares) {
+		return pool, withdraw, sdk.NewError(keeper.codespace, types.CodeInvalidInput, fmt.Sprintf("shares must be positive and at most %s", pool.TotalShares))
+	}
+
+	withdrawA := sdk.NewCoin(pool.ReserveA.Denom, shares.Mul(pool.ReserveA.Amount).Quo(pool.TotalShares))
+	withdrawB := sdk.NewCoin(pool.ReserveB.Denom, shares.Mul(pool.ReserveB.Amount).Quo(pool.TotalShares))
+	withdraw = sdk.NewCoins(withdrawA, withdrawB)
+	if !withdraw.IsAllGTE(minWithdraw) {
+		return pool, withdraw, sdk.NewError(keeper.codespace, types.CodeSlippage, fmt.Sprintf("withdraw %s is less than %s", withdraw, minWithdraw))
+	}
+
+	_, err = keeper.bankKeeper.SubtractCoins(ctx, sender, sdk.NewCoins(sdk.NewCoin(pool.ShareDenom, shares)))
+	if err != nil {
+		return
+	}
+	err = keeper.bankKeeper.SendCoins(ctx, PoolReservesAccAddr, sender, withdraw)
+	if err != nil {
+		return
+	}
+
+	pool = pool.SubReserve(withdrawA).SubReserve(withdrawB)
+	pool.TotalShares = pool.TotalShares.Sub(shares)
+	keeper.setPool(ctx, pool)
+
+	return pool, withdraw, nil
+}
+
+// Swaps input for the other denom of the pool, fails if the output is less than minOutput
+func (keeper Keeper) Swap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coin,
+	minOutput sdk.Coin) (pool types.Pool, quote types.SwapQuote, err sdk.Error) {
+	pool, quote, err = keeper.QuoteSwap(ctx, poolId, input)
+	if err != nil {
+		return
+	}
+	if minOutput.Denom != quote.Output.Denom {
+		return pool, quote, sdk.NewError(keeper.codespace, types.CodeNotMatchTarget, fmt.Sprintf("%s doesn't match the output of the pool(%s)", minOutput.Denom, quote.Output.Denom))
+	}
+	if quote.Output.IsLT(minOutput) {
+		return pool, quote, sdk.NewError(keeper.codespace, types.CodeSlippage, fmt.Sprintf("output %s is less than %s", quote.Output, minOutput))
+	}
+
+	err = keeper.bankKeeper.SendCoins(ctx, sender, PoolReservesAccAddr, sdk.NewCoins(input))
+	if err != nil {
+		return
+	}
+	err = keeper.bankKeeper.SendCoins(ctx, PoolReservesAccAddr, sender, sdk.NewCoins(quote.Output))
+	if err != nil {
+		return
+	}
+
+	pool = pool.AddReserve(input).SubReserve(quote.Output)
+	keeper.setPool(ctx, pool)
+
+	return pool, quote, nil
+}
+
+// Returns the result of a swap of input against the current reserves of a pool
+func (keeper Keeper) QuoteSwap(ctx sdk.Context, poolId uint64, input sdk.Coin) (pool types.Pool, quote types.SwapQuote, err sdk.Error) {
+	pool, ok := keeper.GetPool(ctx, poolId)
+	if !ok {
+		return pool, quote, sdk.NewError(keeper.codespace, types.CodePoolNotExist, fmt.Sprintf("this poolId is invalid : %d", poolId))
+	}
+	if !input.IsPositive() {
+		return pool, quote, sdk.NewError(keeper.codespace, types.CodeInvalidInput, fmt.Sprintf("input is invalid: %s", input))
+	}
+	if _, _, ok := pool.GetReserves(input.Denom); !ok {
+		return pool, quote, sdk.NewError(keeper.codespace, types.CodeNotMatchTarget, fmt.Sprintf("%s doesn't match the denoms of pool %d", input.Denom, poolId))
+	}
+
+	output, fee, ok := pool.GetSwapOutput(input, keeper.GetParams(ctx).PoolFeeRate)
+	if !ok {
+		return pool, quote, sdk.NewError(keeper.codespace, types.CodeTooLess, fmt.Sprintf("pool %d has no liquidity", poolId))
+	}
+	if !output.IsPositive() {
+		return pool, quote, sdk.NewError(keeper.codespace, types.CodeTooLess, fmt.Sprintf("input %s is too less to swap", input))
+	}
+
+	quote = types.SwapQuote{
+		PoolId: poolId,
+		Input:  input,
+		Output: output,
+		Fee:    fee,
+		Price:  sdk.NewDecFromInt(output.Amount).QuoInt(input.Amount),
+	}
+	return pool, quote, nil
+}
+
+// Returns the coins held by the pool reserves account
+func (keeper Keeper) GetPoolReserves(ctx sdk.Context) sdk.Coins {
+	return keeper.bankKeeper.GetCoins(ctx, PoolReservesAccAddr)
+}
+
+// Returns the quotient rounded up
+func ceilQuo(dividend sdk.Int, divisor sdk.Int) sdk.Int {
+	return dividend.Add(divisor).Sub(sdk.OneInt()).Quo(divisor)
+}
+
+// Store level
+func (keeper Keeper) GetPool(ctx sdk.Context, poolId uint64) (types.Pool, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyPool(poolId))
+	if bz == nil {
+		return types.Pool{}, false
+	}
+	var pool types.Pool
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pool)
+	return pool, true
+}
+
+// Returns the id of the pool of a pair in either direction
+func (keeper Keeper) GetPoolIdByDenoms(ctx sdk.Context, denom1 string, denom2 string) (poolId uint64, ok bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyPoolPair(denom1, denom2))
+	if bz == nil {
+		return 0, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &poolId)
+	return poolId, true
+}
+
+// Returns all pools by pool id
+func (keeper Keeper) GetPools(ctx sdk.Context) types.Pools {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyPools())
+	defer iterator.Close()
+
+	pools := types.Pools{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.Pool
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+func (keeper Keeper) setPool(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyPool(pool.PoolId), keeper.cdc.MustMarshalBinaryLengthPrefixed(pool))
+}
+
+// Set a pool with its pair, the next pool id follows the pool
+func (keeper Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
+	keeper.setPool(ctx, pool)
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyPoolPair(pool.ReserveA.Denom, pool.ReserveB.Denom), keeper.cdc.MustMarshalBinaryLengthPrefixed(pool.PoolId))
+	if keeper.peekNextPoolId(ctx) <= pool.PoolId {
+		store.Set(KeyNextPoolId, keeper.cdc.MustMarshalBinaryLengthPrefixed(pool.PoolId+1))
+	}
+}
+
+// Get the next available PoolId and increments it
+func (keeper Keeper) getNewPoolId(ctx sdk.Context) (poolId uint64) {
+	poolId = keeper.peekNextPoolId(ctx)
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyNextPoolId, keeper.cdc.MustMarshalBinaryLengthPrefixed(poolId+1))
+	return poolId
+}
+
+// Peeks the next available PoolId without incrementing it
+func (keeper Keeper) peekNextPoolId(ctx sdk.Context) (poolId uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyNextPoolId)
+	if bz == nil {
+		return 1
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &poolId)
+	return poolId
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+// Records a fill in the trade history of its pair with the current block height and time
+func (keeper Keeper) addTrade(ctx sdk.Context, fill types.Fill) types.Fill {
+	tradeId := keeper.getNewTradeId(ctx)
+	fill.TradeId = tradeId
+	fill.Height = ctx.BlockHeight()
+	fill.Time = ctx.BlockHeader().Time
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyTrade(fill.Supply.Denom, fill.Target.Denom, tradeId), keeper.cdc.MustMarshalBinaryLengthPrefixed(fill))
+	return fill
+}
+
+// Get the next available TradeId and increments it
+func (keeper Keeper) getNewTradeId(ctx sdk.Context) (tradeId uint64) {
+	tradeId = keeper.PeekNextTradeId(ctx)
+	keeper.SetNextTradeId(ctx, tradeId+1)
+	return tradeId
+}
+
+// Peeks the next available TradeId without incrementing it
+func (keeper Keeper) PeekNextTradeId(ctx sdk.Context) (tradeId uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyNextTradeId)
+	if bz == nil {
+		return 1
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tradeId)
+	return tradeId
+}
+
+// Set the next available TradeId
+func (keeper Keeper) SetNextTradeId(ctx sdk.Context, tradeId uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyNextTradeId, keeper.cdc.MustMarshalBinaryLengthPrefixed(tradeId))
+}
+
+// Set a recorded trade, the next trade id follows the trade
+func (keeper Keeper) SetTrade(ctx sdk.Context, fill types.Fill) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyTrade(fill.Supply.Denom, fill.Target.Denom, fill.TradeId), keeper.cdc.MustMarshalBinaryLengthPrefixed(fill))
+	if keeper.PeekNextTradeId(ctx) <= fill.TradeId {
+		keeper.SetNextTradeId(ctx, fill.TradeId+1)
+	}
+}
+
+// Returns the trades of all pairs, by pair and trade id
+func (keeper Keeper) GetAllTrades(ctx sdk.Context) types.Fills {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyAllTrades())
+	defer iterator.Close()
+
+	trades := types.Fills{}
+	for ; iterator.Valid(); iterator.Next() {
+		var fill types.Fill
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &fill)
+		trades = append(trades, fill)
+	}
+	return trades
+}
+
+// Returns the latest trades of a pair, the latest first
+func (keeper Keeper) GetTrades(ctx sdk.Context, base string, quote string, limit int) []types.Fill {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, PrefixKeyTrades(base, quote))
+	defer iterator.Close()
+
+	trades := make([]types.Fill, 0)
+	for ; iterator.Valid() && len(trades) < limit; iterator.Next() {
+		var fill types.Fill
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &fill)
+		trades = append(trades, fill)
+	}
+	return trades
+}
+
+// Returns the latest candles of a pair at an interval in seconds, the latest first.
+// Intervals without trades are skipped
+func (keeper Keeper) GetCandles(ctx sdk.Context, base string, quote string, interval int64, limit int) types.Candles {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, PrefixKeyTrades(base, quote))
+	defer iterator.Close()
+
+	candles := types.Candles{}
+	for ; iterator.Valid(); iterator.Next() {
+		var fill types.Fill
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &fill)
+
+		startTime := fill.Time.Unix() - fill.Time.Unix()%interval
+		price := fill.Price(base)
+		volume := fill.Volume(base)
+
+		last := len(candles) - 1
+		if last >= 0 && candles[last].Time == startTime {
+			// trades are visited from the latest, so the earlier trade opens the candle
+			candles[last].Open = price
+			if price.GT(candles[last].High) {
+				candles[last].High = price
+			}
+			if price.LT(candles[last].Low) {
+				candles[last].Low = price
+			}
+			candles[last].Volume = candles[last].Volume.Add(volume)
+			candles[last].Trades++
+			continue
+		}
+		if len(candles) >= limit {
+			break
+		}
+		candles = append(candles, types.NewCandle(startTime, price, volume))
+	}
+	return candles
+}
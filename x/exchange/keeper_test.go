@@ -9,6 +9,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/hashgard/hashgard/x/exchange/types"
 )
 
 func TestGetSetOrder(t *testing.T) {
@@ -19,7 +21,7 @@ func TestGetSetOrder(t *testing.T) {
 
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	order, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200))
+	order, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
 	require.NoError(t, err)
 	orderId := order.OrderId
 
@@ -35,9 +37,9 @@ func TestIncrementOrderNumber(t *testing.T) {
 
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("foocoin", 200))
-	keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("foocoin", 200))
-	order3, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("foocoin", 200))
+	keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	order3, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
 	require.NoError(t, err)
 
 	require.Equal(t, uint64(3), order3.OrderId)
@@ -51,7 +53,7 @@ func TestWithrawalOrder(t *testing.T) {
 
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	order, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200))
+	order, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
 	require.NoError(t, err)
 
 	_, err = keeper.WithdrawalOrder(ctx, order.OrderId, order.Seller)
@@ -71,10 +73,165 @@ func TestTakeOrder(t *testing.T) {
 
 	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
 
-	order, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200))
+	order, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
 	require.NoError(t, err)
 
-	_, _, soldOut, err := keeper.TakeOrder(ctx, order.OrderId, acc2.Address, sdk.NewInt64Coin("foocoin", 100))
+	_, soldOut, err := keeper.TakeOrder(ctx, order.OrderId, acc2.Address, sdk.NewInt64Coin("foocoin", 100))
 	require.NoError(t, err)
 	require.False(t, soldOut)
 }
+
+func TestTakeOrderFees(t *testing.T) {
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)))
+	acc2 := auth.NewBaseAccountWithAddress(Addrs[1])
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 20000)))
+
+	genState := DefaultGenesisState()
+	genState.Params = ExchangeParams{
+		MakerFeeRate: 100,
+		TakerFeeRate: 50,
+		PairFees:     []PairFee{{SupplyDenom: "foocoin", TargetDenom: "barcoin", MakerFeeRate: 0, TakerFeeRate: 0}},
+	}
+	require.Nil(t, ValidateGenesis(genState))
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, genState, []auth.Account{&acc1, &acc2})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	params := keeper.GetParams(ctx)
+	makerFeeRate, takerFeeRate := params.GetFeeRates("barcoin", "foocoin")
+	require.Equal(t, uint64(0), makerFeeRate)
+	require.Equal(t, uint64(0), takerFeeRate)
+
+	order, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), sdk.NewInt64Coin("foocoin", 20000), TimeInForce{})
+	require.NoError(t, err)
+
+	fill, soldOut, err := keeper.TakeOrder(ctx, order.OrderId, acc2.Address, sdk.NewInt64Coin("foocoin", 10000))
+	require.NoError(t, err)
+	require.False(t, soldOut)
+	require.Equal(t, sdk.NewInt64Coin("foocoin", 100), fill.MakerFee)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 25), fill.TakerFee)
+
+	require.Equal(t, int64(9900), mapp.AccountKeeper.GetAccount(ctx, acc1.Address).GetCoins().AmountOf("foocoin").Int64())
+	require.Equal(t, int64(4975), mapp.AccountKeeper.GetAccount(ctx, acc2.Address).GetCoins().AmountOf(sdk.DefaultBondDenom).Int64())
+	treasury := mapp.AccountKeeper.GetAccount(ctx, keeper.GetTreasury(ctx)).GetCoins()
+	require.True(t, treasury.IsEqual(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25), sdk.NewInt64Coin("foocoin", 100))))
+	require.Nil(t, FrozenCoinsInvariant(keeper)(ctx))
+
+	genState.Params.TakerFeeRate = 10001
+	require.Error(t, ValidateGenesis(genState))
+}
+
+func TestGetOrdersFiltered(t *testing.T) {
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	acc2 := auth.NewBaseAccountWithAddress(Addrs[1])
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1000)))
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, GenesisState{}, []auth.Account{&acc1, &acc2})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	var orderIds []uint64
+	for i := 0; i < 12; i++ {
+		target := sdk.NewInt64Coin("foocoin", 200)
+		if i%2 == 1 {
+			target = sdk.NewInt64Coin("barcoin", 200)
+		}
+		order, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), target, TimeInForce{})
+		require.NoError(t, err)
+		orderIds = append(orderIds, order.OrderId)
+	}
+	order, _, err := keeper.CreateOrder(ctx, acc2.Address, sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin("barcoin", 10), TimeInForce{})
+	require.NoError(t, err)
+
+	require.Len(t, keeper.GetOrdersFiltered(ctx, nil, "", "", 0, 0), 13)
+	require.Len(t, keeper.GetAddressOrders(ctx, acc1.Address), 12)
+	require.Len(t, keeper.GetOrdersFiltered(ctx, acc2.Address, "", "", 0, 0), 1)
+	require.Len(t, keeper.GetOrdersFiltered(ctx, nil, "foocoin", "", 0, 0), 1)
+	require.Len(t, keeper.GetOrdersFiltered(ctx, nil, "", "foocoin", 0, 0), 6)
+	require.Len(t, keeper.GetOrdersFiltered(ctx, acc1.Address, "", "barcoin", 0, 0), 6)
+
+	// pages follow the order ids
+	page := keeper.GetOrdersFiltered(ctx, nil, sdk.DefaultBondDenom, "foocoin", 0, 4)
+	require.Len(t, page, 4)
+	require.Equal(t, orderIds[6], page[3].OrderId)
+	page = keeper.GetOrdersFiltered(ctx, nil, sdk.DefaultBondDenom, "foocoin", page[3].OrderId+1, 4)
+	require.Len(t, page, 2)
+	require.Equal(t, orderIds[8], page[0].OrderId)
+	require.Equal(t, orderIds[10], page[1].OrderId)
+
+	// the indexes follow the removal of an order
+	_, err = keeper.WithdrawalOrder(ctx, order.OrderId, acc2.Address)
+	require.NoError(t, err)
+	require.Len(t, keeper.GetAddressOrders(ctx, acc2.Address), 0)
+	require.Len(t, keeper.GetOrdersFiltered(ctx, nil, "foocoin", "", 0, 0), 0)
+	require.Len(t, keeper.GetOrdersFiltered(ctx, nil, "", "barcoin", 0, 0), 6)
+}
+
+func TestMigrateStore(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	require.Equal(t, StoreVersion, keeper.GetStoreVersion(ctx))
+	count, done := keeper.MigrateStore(ctx)
+	require.Equal(t, 0, count)
+	require.True(t, done)
+
+	order, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	require.NoError(t, err)
+
+	keeper.SetStoreVersion(ctx, 0)
+	// the legacy indexes are deleted in a first block and the orders are rewritten in the next
+	BeginBlocker(ctx, keeper)
+	require.Error(t, keeper.CheckStoreVersion(ctx))
+	BeginBlocker(ctx, keeper)
+	require.Equal(t, StoreVersion, keeper.GetStoreVersion(ctx))
+
+	migrated, ok := keeper.GetOrder(ctx, order.OrderId)
+	require.True(t, ok)
+	require.Equal(t, order.OrderId, migrated.OrderId)
+	require.Equal(t, []uint64{order.OrderId}, keeper.GetAddressOrders(ctx, addrs[0]))
+	require.Len(t, keeper.GetBookOrders(ctx, sdk.DefaultBondDenom, "foocoin"), 1)
+}
+
+func TestMigrateStoreInBatches(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1, GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	for i := 0; i <= types.MaxMigratedKeysPerBlock; i++ {
+		_, _, err := keeper.CreateOrder(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.NewInt64Coin("foocoin", 2), TimeInForce{})
+		require.NoError(t, err)
+	}
+
+	keeper.SetStoreVersion(ctx, 0)
+	handler := NewHandler(keeper)
+	msg := NewMsgCreateOrder(addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.NewInt64Coin("foocoin", 2), TimeInForce{})
+
+	// the book and price index keys take three blocks, the orders two
+	migrated := 0
+	for blocks := 1; blocks <= 5; blocks++ {
+		require.False(t, handler(ctx, msg).IsOK())
+		count, done := keeper.MigrateStore(ctx)
+		migrated += count
+		require.Equal(t, blocks == 5, done)
+	}
+	require.Equal(t, types.MaxMigratedKeysPerBlock+1, migrated)
+	require.Equal(t, StoreVersion, keeper.GetStoreVersion(ctx))
+	require.Len(t, keeper.GetAddressOrders(ctx, addrs[0]), types.MaxMigratedKeysPerBlock+1)
+	require.Len(t, keeper.GetBookOrders(ctx, sdk.DefaultBondDenom, "foocoin"), types.MaxMigratedKeysPerBlock+1)
+	require.True(t, handler(ctx, msg).IsOK())
+}
@@ -9,6 +9,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateOrder{}, "hashgard/MsgCreateOrder", nil)
 	cdc.RegisterConcrete(MsgWithdrawalOrder{}, "hashgard/MsgWithdrawalOrder", nil)
 	cdc.RegisterConcrete(MsgTakeOrder{}, "hashgard/MsgTakeOrder", nil)
+	cdc.RegisterConcrete(MsgCreatePool{}, "hashgard/MsgCreatePool", nil)
+	cdc.RegisterConcrete(MsgAddLiquidity{}, "hashgard/MsgAddLiquidity", nil)
+	cdc.RegisterConcrete(MsgRemoveLiquidity{}, "hashgard/MsgRemoveLiquidity", nil)
+	cdc.RegisterConcrete(MsgSwap{}, "hashgard/MsgSwap", nil)
 }
 
 // generic sealed codec to be used throughout sdk
@@ -0,0 +1,59 @@
+package msgs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+var _ sdk.Msg = MsgAddLiquidity{}
+
+type MsgAddLiquidity struct {
+	PoolId     uint64         `json:"pool_id"`
+	Sender     sdk.AccAddress `json:"sender"`
+	MaxDeposit sdk.Coins      `json:"max_deposit"`
+	MinShares  sdk.Int        `json:"min_shares"`
+}
+
+func NewMsgAddLiquidity(poolId uint64, sender sdk.AccAddress, maxDeposit sdk.Coins, minShares sdk.Int) MsgAddLiquidity {
+	return MsgAddLiquidity{
+		PoolId:     poolId,
+		Sender:     sender,
+		MaxDeposit: maxDeposit,
+		MinShares:  minShares,
+	}
+}
+
+// implement Msg interface
+func (msg MsgAddLiquidity) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgAddLiquidity) Type() string {
+	return "add_liquidity"
+}
+
+func (msg MsgAddLiquidity) ValidateBasic() sdk.Error {
+	if msg.PoolId == 0 {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "pool_id is invalid")
+	}
+	if msg.Sender.Empty() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "sender address is nil")
+	}
+	if len(msg.MaxDeposit) != 2 || !msg.MaxDeposit.IsValid() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "max deposit must be two positive coins: "+msg.MaxDeposit.String())
+	}
+	if msg.MinShares.IsNil() || msg.MinShares.IsNegative() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "min shares is invalid: "+msg.MinShares.String())
+	}
+
+	return nil
+}
+
+func (msg MsgAddLiquidity) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAddLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -8,16 +8,18 @@ import (
 var _ sdk.Msg = MsgCreateOrder{}
 
 type MsgCreateOrder struct {
-	Seller sdk.AccAddress `json:"seller"`
-	Supply sdk.Coin       `json:"supply"`
-	Target sdk.Coin       `json:"target"`
+	Seller      sdk.AccAddress    `json:"seller"`
+	Supply      sdk.Coin          `json:"supply"`
+	Target      sdk.Coin          `json:"target"`
+	TimeInForce types.TimeInForce `json:"time_in_force"`
 }
 
-func NewMsgCreateOrder(seller sdk.AccAddress, supply sdk.Coin, target sdk.Coin) MsgCreateOrder {
+func NewMsgCreateOrder(seller sdk.AccAddress, supply sdk.Coin, target sdk.Coin, tif types.TimeInForce) MsgCreateOrder {
 	return MsgCreateOrder{
-		Seller: seller,
-		Supply: supply,
-		Target: target,
+		Seller:      seller,
+		Supply:      supply,
+		Target:      target,
+		TimeInForce: tif,
 	}
 }
 
@@ -40,6 +42,9 @@ func (msg MsgCreateOrder) ValidateBasic() sdk.Error {
 	if msg.Target.Amount.LTE(sdk.ZeroInt()) {
 		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "target amount is invalid: "+msg.Target.String())
 	}
+	if err := msg.TimeInForce.Validate(); err != nil {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, err.Error())
+	}
 
 	return nil
 }
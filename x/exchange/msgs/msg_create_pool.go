@@ -0,0 +1,49 @@
+package msgs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+var _ sdk.Msg = MsgCreatePool{}
+
+type MsgCreatePool struct {
+	Sender  sdk.AccAddress `json:"sender"`
+	Deposit sdk.Coins      `json:"deposit"`
+}
+
+func NewMsgCreatePool(sender sdk.AccAddress, deposit sdk.Coins) MsgCreatePool {
+	return MsgCreatePool{
+		Sender:  sender,
+		Deposit: deposit,
+	}
+}
+
+// implement Msg interface
+func (msg MsgCreatePool) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgCreatePool) Type() string {
+	return "create_pool"
+}
+
+func (msg MsgCreatePool) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "sender address is nil")
+	}
+	if len(msg.Deposit) != 2 || !msg.Deposit.IsValid() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "deposit must be two positive coins: "+msg.Deposit.String())
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePool) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreatePool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -0,0 +1,59 @@
+package msgs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+var _ sdk.Msg = MsgRemoveLiquidity{}
+
+type MsgRemoveLiquidity struct {
+	PoolId      uint64         `json:"pool_id"`
+	Sender      sdk.AccAddress `json:"sender"`
+	Shares      sdk.Int        `json:"shares"`
+	MinWithdraw sdk.Coins      `json:"min_withdraw"`
+}
+
+func NewMsgRemoveLiquidity(poolId uint64, sender sdk.AccAddress, shares sdk.Int, minWithdraw sdk.Coins) MsgRemoveLiquidity {
+	return MsgRemoveLiquidity{
+		PoolId:      poolId,
+		Sender:      sender,
+		Shares:      shares,
+		MinWithdraw: minWithdraw,
+	}
+}
+
+// implement Msg interface
+func (msg MsgRemoveLiquidity) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgRemoveLiquidity) Type() string {
+	return "remove_liquidity"
+}
+
+func (msg MsgRemoveLiquidity) ValidateBasic() sdk.Error {
+	if msg.PoolId == 0 {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "pool_id is invalid")
+	}
+	if msg.Sender.Empty() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "sender address is nil")
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "shares is invalid")
+	}
+	if !msg.MinWithdraw.IsValid() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "min withdraw is invalid: "+msg.MinWithdraw.String())
+	}
+
+	return nil
+}
+
+func (msg MsgRemoveLiquidity) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRemoveLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
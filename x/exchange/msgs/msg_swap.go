@@ -0,0 +1,59 @@
+package msgs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+var _ sdk.Msg = MsgSwap{}
+
+type MsgSwap struct {
+	PoolId    uint64         `json:"pool_id"`
+	Sender    sdk.AccAddress `json:"sender"`
+	Input     sdk.Coin       `json:"input"`
+	MinOutput sdk.Coin       `json:"min_output"`
+}
+
+func NewMsgSwap(poolId uint64, sender sdk.AccAddress, input sdk.Coin, minOutput sdk.Coin) MsgSwap {
+	return MsgSwap{
+		PoolId:    poolId,
+		Sender:    sender,
+		Input:     input,
+		MinOutput: minOutput,
+	}
+}
+
+// implement Msg interface
+func (msg MsgSwap) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgSwap) Type() string {
+	return "swap"
+}
+
+func (msg MsgSwap) ValidateBasic() sdk.Error {
+	if msg.PoolId == 0 {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "pool_id is invalid")
+	}
+	if msg.Sender.Empty() {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "sender address is nil")
+	}
+	if msg.Input.Amount.LTE(sdk.ZeroInt()) {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "input is invalid: "+msg.Input.String())
+	}
+	if msg.MinOutput.Amount.IsNegative() || msg.MinOutput.Denom == msg.Input.Denom {
+		return sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "min output is invalid: "+msg.MinOutput.String())
+	}
+
+	return nil
+}
+
+func (msg MsgSwap) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
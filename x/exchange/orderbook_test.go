@@ -0,0 +1,149 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+func TestMatchOrder(t *testing.T) {
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	acc2 := auth.NewBaseAccountWithAddress(Addrs[1])
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1000)))
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, GenesisState{}, []auth.Account{&acc1, &acc2})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	// resting asks at 3 and 2 foocoin per stake, the cheaper one is created later
+	order1, fills, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 300), TimeInForce{})
+	require.NoError(t, err)
+	require.Len(t, fills, 0)
+	order2, fills, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	require.NoError(t, err)
+	require.Len(t, fills, 0)
+
+	depth := keeper.GetDepth(ctx, sdk.DefaultBondDenom, "foocoin")
+	require.Len(t, depth.Asks, 2)
+	require.True(t, depth.Asks[0].Price.Equal(sdk.NewDec(2)))
+	require.Len(t, depth.Bids, 0)
+
+	// a bid below the best ask rests on the book
+	order3, fills, err := keeper.CreateOrder(ctx, acc2.Address, sdk.NewInt64Coin("foocoin", 100), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), TimeInForce{})
+	require.NoError(t, err)
+	require.Len(t, fills, 0)
+	require.True(t, keeper.HasOrder(ctx, order3.OrderId))
+
+	// a crossing bid fills the best ask first and then partially the next one
+	order4, fills, err := keeper.CreateOrder(ctx, acc2.Address, sdk.NewInt64Coin("foocoin", 500), sdk.NewInt64Coin(sdk.DefaultBondDenom, 150), TimeInForce{})
+	require.NoError(t, err)
+	require.Len(t, fills, 2)
+	require.Equal(t, order2.OrderId, fills[0].OrderId)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), fills[0].Supply)
+	require.Equal(t, sdk.NewInt64Coin("foocoin", 200), fills[0].Target)
+	require.Equal(t, order1.OrderId, fills[1].OrderId)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), fills[1].Supply)
+	require.Equal(t, sdk.NewInt64Coin("foocoin", 300), fills[1].Target)
+	require.False(t, keeper.HasOrder(ctx, order1.OrderId))
+	require.False(t, keeper.HasOrder(ctx, order2.OrderId))
+	require.False(t, keeper.HasOrder(ctx, order4.OrderId))
+
+	require.Equal(t, int64(500), mapp.AccountKeeper.GetAccount(ctx, acc1.Address).GetCoins().AmountOf("foocoin").Int64())
+	require.Equal(t, int64(200), mapp.AccountKeeper.GetAccount(ctx, acc2.Address).GetCoins().AmountOf(sdk.DefaultBondDenom).Int64())
+	require.Nil(t, FrozenCoinsInvariant(keeper)(ctx))
+}
+
+func TestGetBookOrdersByPrice(t *testing.T) {
+	large := sdk.NewInt(1000000000000000000)
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, large.AddRaw(1000))))
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, GenesisState{}, []auth.Account{&acc1})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	order1, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 100), TimeInForce{})
+	require.NoError(t, err)
+	order2, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300), sdk.NewInt64Coin("foocoin", 100), TimeInForce{})
+	require.NoError(t, err)
+	// the price is a little below the price of order2 but the same with 18 decimals
+	order3, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewCoin(sdk.DefaultBondDenom, large), sdk.NewInt64Coin("foocoin", 333333333333333333), TimeInForce{})
+	require.NoError(t, err)
+
+	orders := keeper.GetBookOrders(ctx, sdk.DefaultBondDenom, "foocoin")
+	require.Len(t, orders, 3)
+	require.Equal(t, order3.OrderId, orders[0].OrderId)
+	require.Equal(t, order2.OrderId, orders[1].OrderId)
+	require.Equal(t, order1.OrderId, orders[2].OrderId)
+
+	_, err = keeper.WithdrawalOrder(ctx, order2.OrderId, acc1.Address)
+	require.NoError(t, err)
+	orders = keeper.GetBookOrders(ctx, sdk.DefaultBondDenom, "foocoin")
+	require.Len(t, orders, 2)
+	require.Equal(t, order3.OrderId, orders[0].OrderId)
+	require.Equal(t, order1.OrderId, orders[1].OrderId)
+}
+
+// rejects the coins of a denom sent to an address, as a frozen or not allowlisted holder
+type rejectingBankKeeper struct {
+	types.BankKeeper
+	addr  sdk.AccAddress
+	denom string
+}
+
+func (bk rejectingBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if toAddr.Equals(bk.addr) && amt.AmountOf(bk.denom).IsPositive() {
+		return sdk.ErrUnauthorized("receiver rejected")
+	}
+	return bk.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+func TestMatchOrderSkipsUnpayableOrder(t *testing.T) {
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	acc2 := auth.NewBaseAccountWithAddress(Addrs[1])
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	acc3 := auth.NewBaseAccountWithAddress(Addrs[2])
+	acc3.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1000)))
+
+	mapp, keeper, _, _, _, _ := getMockAppWithBankKeeper(t, 0, GenesisState{}, []auth.Account{&acc1, &acc2, &acc3},
+		func(bk types.BankKeeper) types.BankKeeper {
+			return rejectingBankKeeper{bk, acc1.Address, "foocoin"}
+		})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	// the best ask belongs to a seller who can not receive foocoin
+	order1, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 100), TimeInForce{})
+	require.NoError(t, err)
+	order2, _, err := keeper.CreateOrder(ctx, acc2.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	require.NoError(t, err)
+
+	_, fills, err := keeper.CreateOrder(ctx, acc3.Address, sdk.NewInt64Coin("foocoin", 200), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), TimeInForce{})
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	require.Equal(t, order2.OrderId, fills[0].OrderId)
+
+	// the unpayable order is cancelled and its supply returned
+	require.False(t, keeper.HasOrder(ctx, order1.OrderId))
+	require.Equal(t, int64(1000), mapp.AccountKeeper.GetAccount(ctx, acc1.Address).GetCoins().AmountOf(sdk.DefaultBondDenom).Int64())
+	require.Equal(t, int64(100), mapp.AccountKeeper.GetAccount(ctx, acc3.Address).GetCoins().AmountOf(sdk.DefaultBondDenom).Int64())
+	require.Nil(t, FrozenCoinsInvariant(keeper)(ctx))
+}
@@ -0,0 +1,84 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestPoolLiquidityAndSwap(t *testing.T) {
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), sdk.NewInt64Coin("foocoin", 10000)))
+	acc2 := auth.NewBaseAccountWithAddress(Addrs[1])
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), sdk.NewInt64Coin("foocoin", 10000)))
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, GenesisState{}, []auth.Account{&acc1, &acc2})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	keeper.SetParams(ctx, ExchangeParams{PoolFeeRate: 30})
+
+	pool, shares, err := keeper.CreatePool(ctx, acc1.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("foocoin", 4000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("pool1", 2000), shares)
+	require.Equal(t, int64(2000), mapp.AccountKeeper.GetAccount(ctx, acc1.Address).GetCoins().AmountOf("pool1").Int64())
+
+	_, _, err = keeper.CreatePool(ctx, acc2.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("foocoin", 10)))
+	require.Error(t, err)
+
+	// the fee of 3 stays in the pool, 4000 * 997 / 1997 = 1996
+	input := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	_, quote, err := keeper.QuoteSwap(ctx, pool.PoolId, input)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("foocoin", 1996), quote.Output)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 3), quote.Fee)
+
+	_, _, err = keeper.Swap(ctx, acc2.Address, pool.PoolId, input, sdk.NewInt64Coin("foocoin", 1997))
+	require.Error(t, err)
+	pool, _, err = keeper.Swap(ctx, acc2.Address, pool.PoolId, input, sdk.NewInt64Coin("foocoin", 1996))
+	require.NoError(t, err)
+	require.Equal(t, int64(2004), pool.ReserveA.Amount.Int64())
+	require.Equal(t, int64(2000), pool.ReserveB.Amount.Int64())
+	require.Equal(t, int64(11996), mapp.AccountKeeper.GetAccount(ctx, acc2.Address).GetCoins().AmountOf("foocoin").Int64())
+
+	// only the part in the ratio of the reserves is deposited
+	_, _, _, err = keeper.AddLiquidity(ctx, acc2.Address, pool.PoolId,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("foocoin", 1000)), sdk.NewInt(999))
+	require.Error(t, err)
+	pool, deposit, shares, err := keeper.AddLiquidity(ctx, acc2.Address, pool.PoolId,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("foocoin", 1000)), sdk.NewInt(998))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("pool1", 998), shares)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 998), sdk.NewInt64Coin("foocoin", 1000)), deposit)
+	require.Equal(t, int64(2998), pool.TotalShares.Int64())
+
+	_, _, err = keeper.RemoveLiquidity(ctx, acc2.Address, pool.PoolId, sdk.NewInt(998),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 998), sdk.NewInt64Coin("foocoin", 1000)))
+	require.Error(t, err)
+	pool, withdraw, err := keeper.RemoveLiquidity(ctx, acc2.Address, pool.PoolId, sdk.NewInt(998),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 998), sdk.NewInt64Coin("foocoin", 999)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 998), sdk.NewInt64Coin("foocoin", 999)), withdraw)
+	require.Equal(t, int64(2000), pool.TotalShares.Int64())
+	require.True(t, mapp.AccountKeeper.GetAccount(ctx, acc2.Address).GetCoins().AmountOf("pool1").IsZero())
+
+	// removing all shares empties the pool
+	pool, _, err = keeper.RemoveLiquidity(ctx, acc1.Address, pool.PoolId, sdk.NewInt(2000), sdk.Coins{})
+	require.NoError(t, err)
+	require.True(t, pool.IsEmpty())
+	require.True(t, keeper.GetPoolReserves(ctx).IsZero())
+	require.NoError(t, PoolReservesInvariant(keeper)(ctx))
+
+	// coins sent to the pool reserves account by anybody do not break it
+	reservesAcc := mapp.AccountKeeper.GetAccount(ctx, PoolReservesAccAddr)
+	require.NoError(t, reservesAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1))))
+	mapp.AccountKeeper.SetAccount(ctx, reservesAcc)
+	require.NoError(t, PoolReservesInvariant(keeper)(ctx))
+}
@@ -11,9 +11,16 @@ import (
 
 // query endpoints supported by the governance Querier
 const (
-	QueryOrder              = "order"
-	QueryFrozenFund         = "frozen"
-	QueryAllOrdersByAddress = "orders"
+	QueryOrder      = "order"
+	QueryFrozenFund = "frozen"
+	QueryOrders     = "orders"
+	QueryDepth      = "depth"
+	QueryParams     = "params"
+	QueryTrades     = "trades"
+	QueryCandles    = "candles"
+	QueryPool       = "pool"
+	QueryPools      = "pools"
+	QueryQuote      = "quote"
 )
 
 func NewQuerier(keeper keeper.Keeper, cdc *codec.Codec) sdk.Querier {
@@ -23,8 +30,22 @@ func NewQuerier(keeper keeper.Keeper, cdc *codec.Codec) sdk.Querier {
 			return queriers.QueryOrder(ctx, cdc, req, keeper)
 		case QueryFrozenFund:
 			return queriers.QueryFrozenFund(ctx, cdc, req, keeper)
-		case QueryAllOrdersByAddress:
-			return queriers.QueryOrdersByAddress(ctx, cdc, req, keeper)
+		case QueryOrders:
+			return queriers.QueryOrders(ctx, cdc, req, keeper)
+		case QueryParams:
+			return queriers.QueryParams(ctx, cdc, keeper)
+		case QueryTrades:
+			return queriers.QueryTrades(ctx, cdc, req, keeper)
+		case QueryCandles:
+			return queriers.QueryCandles(ctx, cdc, req, keeper)
+		case QueryDepth:
+			return queriers.QueryDepth(ctx, cdc, req, keeper)
+		case QueryPool:
+			return queriers.QueryPool(ctx, cdc, req, keeper)
+		case QueryPools:
+			return queriers.QueryPools(ctx, cdc, keeper)
+		case QueryQuote:
+			return queriers.QueryQuote(ctx, cdc, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown exchange query endpoint")
 		}
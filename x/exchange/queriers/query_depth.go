@@ -0,0 +1,40 @@
+package queriers
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+)
+
+type QueryDepthParams struct {
+	SupplyDenom string
+	TargetDenom string
+}
+
+func NewQueryDepthParams(supplyDenom string, targetDenom string) QueryDepthParams {
+	return QueryDepthParams{
+		SupplyDenom: supplyDenom,
+		TargetDenom: targetDenom,
+	}
+}
+
+func QueryDepth(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params QueryDepthParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	depth := keeper.GetDepth(ctx, params.SupplyDenom, params.TargetDenom)
+
+	bz, err := codec.MarshalJSONIndent(cdc, depth)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err))
+	}
+
+	return bz, nil
+}
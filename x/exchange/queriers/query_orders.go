@@ -0,0 +1,51 @@
+package queriers
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+type QueryOrdersParams struct {
+	Seller       sdk.AccAddress
+	SupplyDenom  string
+	TargetDenom  string
+	StartOrderId uint64
+	Limit        int
+}
+
+func NewQueryOrdersParams(seller sdk.AccAddress, supplyDenom string, targetDenom string, startOrderId uint64, limit int) QueryOrdersParams {
+	return QueryOrdersParams{
+		Seller:       seller,
+		SupplyDenom:  supplyDenom,
+		TargetDenom:  targetDenom,
+		StartOrderId: startOrderId,
+		Limit:        limit,
+	}
+}
+
+// Returns a page of the orders matching the filters, the next page starts after the id of the last order
+func QueryOrders(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params QueryOrdersParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if params.Limit <= 0 {
+		return nil, sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "limit must be positive")
+	}
+
+	orders := types.Orders(keeper.GetOrdersFiltered(ctx, params.Seller, params.SupplyDenom, params.TargetDenom, params.StartOrderId, params.Limit))
+
+	bz, err := codec.MarshalJSONIndent(cdc, orders)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err))
+	}
+
+	return bz, nil
+}
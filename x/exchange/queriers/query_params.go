@@ -0,0 +1,19 @@
+package queriers
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+)
+
+func QueryParams(ctx sdk.Context, cdc *codec.Codec, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(cdc, keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err))
+	}
+
+	return bz, nil
+}
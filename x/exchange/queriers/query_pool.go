@@ -0,0 +1,85 @@
+package queriers
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+type QueryPoolParams struct {
+	PoolId uint64
+}
+
+func NewQueryPoolParams(poolId uint64) QueryPoolParams {
+	return QueryPoolParams{
+		PoolId: poolId,
+	}
+}
+
+type QueryQuoteParams struct {
+	PoolId uint64
+	Input  sdk.Coin
+}
+
+func NewQueryQuoteParams(poolId uint64, input sdk.Coin) QueryQuoteParams {
+	return QueryQuoteParams{
+		PoolId: poolId,
+		Input:  input,
+	}
+}
+
+// Returns a pool with its reserves
+func QueryPool(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params QueryPoolParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	pool, ok := keeper.GetPool(ctx, params.PoolId)
+	if !ok {
+		return nil, sdk.NewError(types.DefaultCodespace, types.CodePoolNotExist, fmt.Sprintf("this poolId is invalid : %d", params.PoolId))
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, pool)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err))
+	}
+
+	return bz, nil
+}
+
+func QueryPools(ctx sdk.Context, cdc *codec.Codec, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(cdc, keeper.GetPools(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err))
+	}
+
+	return bz, nil
+}
+
+// Returns the output of a swap against the current reserves of a pool
+func QueryQuote(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params QueryQuoteParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	_, quote, sdkErr := keeper.QuoteSwap(ctx, params.PoolId, params.Input)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, quote)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err))
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,82 @@
+package queriers
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hashgard/hashgard/x/exchange/keeper"
+	"github.com/hashgard/hashgard/x/exchange/types"
+)
+
+type QueryTradesParams struct {
+	Base  string
+	Quote string
+	Limit int
+}
+
+func NewQueryTradesParams(base string, quote string, limit int) QueryTradesParams {
+	return QueryTradesParams{
+		Base:  base,
+		Quote: quote,
+		Limit: limit,
+	}
+}
+
+type QueryCandlesParams struct {
+	Base     string
+	Quote    string
+	Interval int64
+	Limit    int
+}
+
+func NewQueryCandlesParams(base string, quote string, interval int64, limit int) QueryCandlesParams {
+	return QueryCandlesParams{
+		Base:     base,
+		Quote:    quote,
+		Interval: interval,
+		Limit:    limit,
+	}
+}
+
+func QueryTrades(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params QueryTradesParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if params.Limit <= 0 {
+		return nil, sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "limit must be positive")
+	}
+
+	trades := keeper.GetTrades(ctx, params.Base, params.Quote, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(cdc, trades)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err))
+	}
+
+	return bz, nil
+}
+
+func QueryCandles(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params QueryCandlesParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if params.Interval <= 0 || params.Limit <= 0 {
+		return nil, sdk.NewError(types.DefaultCodespace, types.CodeInvalidInput, "interval and limit must be positive")
+	}
+
+	candles := keeper.GetCandles(ctx, params.Base, params.Quote, params.Interval, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(cdc, candles)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal result to JSON: %s", err))
+	}
+
+	return bz, nil
+}
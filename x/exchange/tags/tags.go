@@ -15,4 +15,17 @@ var (
 	SupplyToken = "supply_token"
 	TargetToken = "target_token"
 	OrderStatus = "order_status"
+	FillOrderId = "fill_order_id"
+	FillSupply  = "fill_supply"
+	FillTarget  = "fill_target"
+	MakerFee    = "maker_fee"
+	TakerFee    = "taker_fee"
+	PoolId      = "pool_id"
+	Shares      = "shares"
+	Deposit     = "deposit"
+	Withdraw    = "withdraw"
+	SwapInput   = "swap_input"
+	SwapOutput  = "swap_output"
+	PoolFee     = "pool_fee"
+	Reason      = "reason"
 )
@@ -17,6 +17,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/hashgard/hashgard/x/exchange/types"
 )
 
 var (
@@ -30,6 +32,14 @@ var (
 func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []auth.Account) (
 	mapp *mock.App, keeper Keeper, sk staking.Keeper, addrs []sdk.AccAddress,
 	pubKeys []crypto.PubKey, privKeys []crypto.PrivKey) {
+	return getMockAppWithBankKeeper(t, numGenAccs, genState, genAccs, nil)
+}
+
+// initialize the mock application for this module, the bank keeper of the exchange is wrapped by wrapBankKeeper
+func getMockAppWithBankKeeper(t *testing.T, numGenAccs int, genState GenesisState, genAccs []auth.Account,
+	wrapBankKeeper func(types.BankKeeper) types.BankKeeper) (
+	mapp *mock.App, keeper Keeper, sk staking.Keeper, addrs []sdk.AccAddress,
+	pubKeys []crypto.PubKey, privKeys []crypto.PrivKey) {
 
 	mapp = mock.NewApp()
 	RegisterCodec(mapp.Cdc)
@@ -41,7 +51,11 @@ func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []a
 	pk := mapp.ParamsKeeper
 	ck := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
 	sk = staking.NewKeeper(mapp.Cdc, keyStaking, tkeyStaking, ck, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
-	keeper = NewKeeper(mapp.Cdc, keyExchange, pk, pk.Subspace("testexchange"), ck, DefaultCodespace)
+	var bk types.BankKeeper = ck
+	if wrapBankKeeper != nil {
+		bk = wrapBankKeeper(ck)
+	}
+	keeper = NewKeeper(mapp.Cdc, keyExchange, pk, pk.Subspace("testexchange"), bk, DefaultCodespace)
 
 	mapp.Router().AddRoute(RouterKey, NewHandler(keeper))
 	mapp.QueryRouter().AddRoute(QuerierRoute, NewQuerier(keeper, mapp.Cdc))
@@ -0,0 +1,63 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestTradesAndCandles(t *testing.T) {
+	acc1 := auth.NewBaseAccountWithAddress(Addrs[0])
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	acc2 := auth.NewBaseAccountWithAddress(Addrs[1])
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1000)))
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, GenesisState{}, []auth.Account{&acc1, &acc2})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Height: 5, Time: time.Unix(36000, 0)})
+
+	order1, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 200), TimeInForce{})
+	require.NoError(t, err)
+	order2, _, err := keeper.CreateOrder(ctx, acc1.Address, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foocoin", 400), TimeInForce{})
+	require.NoError(t, err)
+
+	_, _, err = keeper.TakeOrder(ctx, order1.OrderId, acc2.Address, sdk.NewInt64Coin("foocoin", 100))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 6, Time: time.Unix(36600, 0)})
+	_, _, err = keeper.TakeOrder(ctx, order2.OrderId, acc2.Address, sdk.NewInt64Coin("foocoin", 40))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Height: 7, Time: time.Unix(39600, 0)})
+	_, fills, err := keeper.CreateOrder(ctx, acc2.Address, sdk.NewInt64Coin("foocoin", 100), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), TimeInForce{})
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+
+	trades := keeper.GetTrades(ctx, "foocoin", sdk.DefaultBondDenom, 10)
+	require.Len(t, trades, 3)
+	require.Equal(t, uint64(3), trades[0].TradeId)
+	require.Equal(t, int64(7), trades[0].Height)
+	require.Len(t, keeper.GetTrades(ctx, sdk.DefaultBondDenom, "foocoin", 1), 1)
+
+	candles := keeper.GetCandles(ctx, sdk.DefaultBondDenom, "foocoin", 3600, 10)
+	require.Len(t, candles, 2)
+	require.Equal(t, int64(39600), candles[0].Time)
+	require.True(t, candles[0].Close.Equal(sdk.NewDec(2)))
+	require.Equal(t, int64(36000), candles[1].Time)
+	require.True(t, candles[1].Open.Equal(sdk.NewDec(2)))
+	require.True(t, candles[1].High.Equal(sdk.NewDec(4)))
+	require.True(t, candles[1].Close.Equal(sdk.NewDec(4)))
+	require.Equal(t, int64(60), candles[1].Volume.Int64())
+	require.Equal(t, uint64(2), candles[1].Trades)
+
+	require.Len(t, keeper.GetCandles(ctx, sdk.DefaultBondDenom, "foocoin", 3600, 1), 1)
+}
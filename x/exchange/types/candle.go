@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Candle is the open, high, low, close prices in quote per base and the base volume
+// of the trades of a pair in one interval
+type Candle struct {
+	Time   int64   `json:"time"`
+	Open   sdk.Dec `json:"open"`
+	High   sdk.Dec `json:"high"`
+	Low    sdk.Dec `json:"low"`
+	Close  sdk.Dec `json:"close"`
+	Volume sdk.Int `json:"volume"`
+	Trades uint64  `json:"trades"`
+}
+
+func NewCandle(startTime int64, price sdk.Dec, volume sdk.Int) Candle {
+	return Candle{
+		Time:   startTime,
+		Open:   price,
+		High:   price,
+		Low:    price,
+		Close:  price,
+		Volume: volume,
+		Trades: 1,
+	}
+}
+
+// Candles is an array of candle, the latest first
+type Candles []Candle
+
+func (candles Candles) String() string {
+	out := fmt.Sprintf("%25s - %20s - %20s - %20s - %20s - %20s - Trades\n", "Time", "Open", "High", "Low", "Close", "Volume")
+	for _, c := range candles {
+		out += fmt.Sprintf("%25s - %20s - %20s - %20s - %20s - %20s - %d\n", time.Unix(c.Time, 0).UTC().Format(time.RFC3339),
+			c.Open, c.High, c.Low, c.Close, c.Volume, c.Trades)
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceLevel is the total amount of the resting orders at one price
+type PriceLevel struct {
+	Price  sdk.Dec `json:"price"`
+	Amount sdk.Int `json:"amount"`
+	Orders uint64  `json:"orders"`
+}
+
+func NewPriceLevel(price sdk.Dec, amount sdk.Int) PriceLevel {
+	return PriceLevel{
+		Price:  price,
+		Amount: amount,
+		Orders: 1,
+	}
+}
+
+// Depth is the order book of a pair, the best price first on both sides
+type Depth struct {
+	Asks []PriceLevel `json:"asks"`
+	Bids []PriceLevel `json:"bids"`
+}
+
+func (depth Depth) String() string {
+	out := fmt.Sprintf("%5s - %30s - %20s - %s\n", "Side", "Price", "Amount", "Orders")
+	for _, level := range depth.Asks {
+		out += fmt.Sprintf("%5s - %30s - %20s - %d\n", "ask", level.Price, level.Amount, level.Orders)
+	}
+	for _, level := range depth.Bids {
+		out += fmt.Sprintf("%5s - %30s - %20s - %d\n", "bid", level.Price, level.Amount, level.Orders)
+	}
+	return strings.TrimSpace(out)
+}
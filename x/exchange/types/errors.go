@@ -13,4 +13,9 @@ const (
 	CodeNoPermission   sdk.CodeType = 104
 	CodeNotMatchTarget sdk.CodeType = 105
 	CodeTooLess        sdk.CodeType = 106
+	CodeNotFilled      sdk.CodeType = 107
+	CodePoolNotExist   sdk.CodeType = 108
+	CodePoolExist      sdk.CodeType = 109
+	CodeSlippage       sdk.CodeType = 110
+	CodeMigrating      sdk.CodeType = 111
 )
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Fill is a trade of an order, the taker order id is zero when the order is taken directly
+type Fill struct {
+	TradeId      uint64         `json:"trade_id"`
+	OrderId      uint64         `json:"order_id"`
+	TakerOrderId uint64         `json:"taker_order_id"`
+	Buyer        sdk.AccAddress `json:"buyer"`
+	Seller       sdk.AccAddress `json:"seller"`
+	Supply       sdk.Coin       `json:"supply"`
+	Target       sdk.Coin       `json:"target"`
+	MakerFee     sdk.Coin       `json:"maker_fee"`
+	TakerFee     sdk.Coin       `json:"taker_fee"`
+	Height       int64          `json:"height"`
+	Time         time.Time      `json:"time"`
+}
+
+func NewFill(orderId uint64, takerOrderId uint64, buyer sdk.AccAddress, seller sdk.AccAddress,
+	supply sdk.Coin, target sdk.Coin, makerFee sdk.Coin, takerFee sdk.Coin) Fill {
+	return Fill{
+		OrderId:      orderId,
+		TakerOrderId: takerOrderId,
+		Buyer:        buyer,
+		Seller:       seller,
+		Supply:       supply,
+		Target:       target,
+		MakerFee:     makerFee,
+		TakerFee:     takerFee,
+	}
+}
+
+// Returns the price of the trade in quote per base, base is one of the coins of the trade
+func (fill Fill) Price(base string) sdk.Dec {
+	if fill.Supply.Denom == base {
+		return sdk.NewDecFromInt(fill.Target.Amount).QuoInt(fill.Supply.Amount)
+	}
+	return sdk.NewDecFromInt(fill.Supply.Amount).QuoInt(fill.Target.Amount)
+}
+
+// Returns the traded amount of base
+func (fill Fill) Volume(base string) sdk.Int {
+	if fill.Supply.Denom == base {
+		return fill.Supply.Amount
+	}
+	return fill.Target.Amount
+}
+
+func (fill Fill) String() string {
+	return fmt.Sprintf("trade %d at height %d: order %d filled by order %d: %s -> %s, maker fee %s, taker fee %s",
+		fill.TradeId, fill.Height, fill.OrderId, fill.TakerOrderId, fill.Supply, fill.Target, fill.MakerFee, fill.TakerFee)
+}
+
+// Fills is an array of fill
+type Fills []Fill
+
+func (fills Fills) String() string {
+	out := ""
+	for _, fill := range fills {
+		out += fill.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
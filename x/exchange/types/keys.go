@@ -15,4 +15,10 @@ const (
 
 	// Parameter store default namestore
 	DefaultParamspace = ModuleName
+
+	// Maximum number of orders expired by the EndBlocker in a block, the others carry over to the next blocks
+	MaxExpiredOrdersPerBlock = 100
+
+	// Maximum number of orders or legacy index keys migrated by the BeginBlocker in a block
+	MaxMigratedKeysPerBlock = 1000
 )
@@ -9,12 +9,13 @@ import (
 )
 
 type Order struct {
-	OrderId    uint64         `json:"order_id"`
-	Seller     sdk.AccAddress `json:"seller"`
-	Supply     sdk.Coin       `json:"supply"`
-	Target     sdk.Coin       `json:"target"`
-	Remains    sdk.Coin       `json:"remains"`
-	CreateTime time.Time      `json:"create_time"`
+	OrderId     uint64         `json:"order_id"`
+	Seller      sdk.AccAddress `json:"seller"`
+	Supply      sdk.Coin       `json:"supply"`
+	Target      sdk.Coin       `json:"target"`
+	Remains     sdk.Coin       `json:"remains"`
+	CreateTime  time.Time      `json:"create_time"`
+	TimeInForce TimeInForce    `json:"time_in_force"`
 }
 
 func (order Order) String() string {
@@ -23,8 +24,9 @@ func (order Order) String() string {
 		  Supply:			%s
 		  Target:			%s
 		  Remains:			%s
-		  Create Time:		%s`, order.OrderId, order.Seller, order.Supply,
-		order.Target, order.Remains, order.CreateTime)
+		  Create Time:		%s
+		  Time In Force:	%s`, order.OrderId, order.Seller, order.Supply,
+		order.Target, order.Remains, order.CreateTime, order.TimeInForce)
 }
 
 // Orders is an array of order
@@ -2,13 +2,72 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Fee rates are in basis points of the turnover
+const MaxFeeRate = 10000
+
 type ExchangeParams struct {
-	MaxOrdersPerAddr uint64 `json:"max_orders_per_addr"`
+	MaxOrdersPerAddr uint64         `json:"max_orders_per_addr"`
+	MakerFeeRate     uint64         `json:"maker_fee_rate"`
+	TakerFeeRate     uint64         `json:"taker_fee_rate"`
+	PairFees         []PairFee      `json:"pair_fees"`
+	Treasury         sdk.AccAddress `json:"treasury"`
+	PoolFeeRate      uint64         `json:"pool_fee_rate"`
+}
+
+// Fee rates of a pair which override the default rates, in both directions of the pair
+type PairFee struct {
+	SupplyDenom  string `json:"supply_denom"`
+	TargetDenom  string `json:"target_denom"`
+	MakerFeeRate uint64 `json:"maker_fee_rate"`
+	TakerFeeRate uint64 `json:"taker_fee_rate"`
 }
 
 func (ep ExchangeParams) String() string {
-	return fmt.Sprintf(`Exchange Params:
-  Max Orders Per Address:		%d`, ep.MaxOrdersPerAddr)
+	out := fmt.Sprintf(`Exchange Params:
+  Max Orders Per Address:		%d
+  Maker Fee Rate:			%d bps
+  Taker Fee Rate:			%d bps
+  Treasury:				%s
+  Pool Fee Rate:			%d bps`, ep.MaxOrdersPerAddr, ep.MakerFeeRate, ep.TakerFeeRate, ep.Treasury, ep.PoolFeeRate)
+	for _, pf := range ep.PairFees {
+		out += fmt.Sprintf("\n  Pair %s/%s:			maker %d bps, taker %d bps",
+			pf.SupplyDenom, pf.TargetDenom, pf.MakerFeeRate, pf.TakerFeeRate)
+	}
+	return strings.TrimSpace(out)
+}
+
+// Returns the maker and taker fee rates of a pair
+func (ep ExchangeParams) GetFeeRates(supplyDenom string, targetDenom string) (makerFeeRate uint64, takerFeeRate uint64) {
+	for _, pf := range ep.PairFees {
+		if (pf.SupplyDenom == supplyDenom && pf.TargetDenom == targetDenom) ||
+			(pf.SupplyDenom == targetDenom && pf.TargetDenom == supplyDenom) {
+			return pf.MakerFeeRate, pf.TakerFeeRate
+		}
+	}
+	return ep.MakerFeeRate, ep.TakerFeeRate
+}
+
+func (ep ExchangeParams) Validate() error {
+	if ep.MakerFeeRate > MaxFeeRate || ep.TakerFeeRate > MaxFeeRate || ep.PoolFeeRate >= MaxFeeRate {
+		return fmt.Errorf("fee rates can not exceed %d bps", MaxFeeRate)
+	}
+	for _, pf := range ep.PairFees {
+		if len(pf.SupplyDenom) == 0 || len(pf.TargetDenom) == 0 || pf.SupplyDenom == pf.TargetDenom {
+			return fmt.Errorf("invalid fee pair %s/%s", pf.SupplyDenom, pf.TargetDenom)
+		}
+		if pf.MakerFeeRate > MaxFeeRate || pf.TakerFeeRate > MaxFeeRate {
+			return fmt.Errorf("fee rates of pair %s/%s can not exceed %d bps", pf.SupplyDenom, pf.TargetDenom, MaxFeeRate)
+		}
+	}
+	return nil
+}
+
+// Returns the fee of an amount at a rate in basis points
+func CalcFee(denom string, amount sdk.Int, feeRate uint64) sdk.Coin {
+	return sdk.NewCoin(denom, amount.Mul(sdk.NewIntFromUint64(feeRate)).QuoRaw(MaxFeeRate))
 }
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pool is a constant product liquidity pool of two denoms, ReserveA holds the lower denom.
+// The reserves of all pools are held by the pool reserves account
+type Pool struct {
+	PoolId      uint64         `json:"pool_id"`
+	Creator     sdk.AccAddress `json:"creator"`
+	ReserveA    sdk.Coin       `json:"reserve_a"`
+	ReserveB    sdk.Coin       `json:"reserve_b"`
+	ShareDenom  string         `json:"share_denom"`
+	TotalShares sdk.Int        `json:"total_shares"`
+	CreateTime  time.Time      `json:"create_time"`
+}
+
+func NewPool(poolId uint64, creator sdk.AccAddress, denomA string, denomB string, createTime time.Time) Pool {
+	if denomA > denomB {
+		denomA, denomB = denomB, denomA
+	}
+	return Pool{
+		PoolId:      poolId,
+		Creator:     creator,
+		ReserveA:    sdk.NewCoin(denomA, sdk.ZeroInt()),
+		ReserveB:    sdk.NewCoin(denomB, sdk.ZeroInt()),
+		ShareDenom:  GetShareDenom(poolId),
+		TotalShares: sdk.ZeroInt(),
+		CreateTime:  createTime,
+	}
+}
+
+// Returns the denom of the shares of a pool
+func GetShareDenom(poolId uint64) string {
+	return fmt.Sprintf("pool%d", poolId)
+}
+
+// Returns the reserves of both denoms
+func (pool Pool) Reserves() sdk.Coins {
+	return sdk.NewCoins(pool.ReserveA, pool.ReserveB)
+}
+
+// Returns the reserve of a denom and the reserve of the other denom of the pool
+func (pool Pool) GetReserves(denom string) (reserve sdk.Coin, other sdk.Coin, ok bool) {
+	switch denom {
+	case pool.ReserveA.Denom:
+		return pool.ReserveA, pool.ReserveB, true
+	case pool.ReserveB.Denom:
+		return pool.ReserveB, pool.ReserveA, true
+	default:
+		return reserve, other, false
+	}
+}
+
+// Adds a coin to the reserve of its denom
+func (pool Pool) AddReserve(coin sdk.Coin) Pool {
+	if coin.Denom == pool.ReserveA.Denom {
+		pool.ReserveA = pool.ReserveA.Add(coin)
+	} else {
+		pool.ReserveB = pool.ReserveB.Add(coin)
+	}
+	return pool
+}
+
+// Subtracts a coin from the reserve of its denom
+func (pool Pool) SubReserve(coin sdk.Coin) Pool {
+	if coin.Denom == pool.ReserveA.Denom {
+		pool.ReserveA = pool.ReserveA.Sub(coin)
+	} else {
+		pool.ReserveB = pool.ReserveB.Sub(coin)
+	}
+	return pool
+}
+
+// Whether the pool has no liquidity
+func (pool Pool) IsEmpty() bool {
+	return pool.TotalShares.IsZero() || pool.ReserveA.IsZero() || pool.ReserveB.IsZero()
+}
+
+// Returns the spot price of base in the other denom of the pool
+func (pool Pool) Price(base string) sdk.Dec {
+	reserve, other, ok := pool.GetReserves(base)
+	if !ok || reserve.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(other.Amount).QuoInt(reserve.Amount)
+}
+
+// Returns the output of a swap of input at a fee rate in basis points, the fee is taken from the input and stays in the pool.
+// The product of the reserves does not decrease by the swap
+func (pool Pool) GetSwapOutput(input sdk.Coin, feeRate uint64) (output sdk.Coin, fee sdk.Coin, ok bool) {
+	reserveIn, reserveOut, ok := pool.GetReserves(input.Denom)
+	if !ok || pool.IsEmpty() {
+		return output, fee, false
+	}
+	fee = CalcFee(input.Denom, input.Amount, feeRate)
+	amountIn := input.Amount.Sub(fee.Amount)
+	amountOut := reserveOut.Amount.Mul(amountIn).Quo(reserveIn.Amount.Add(amountIn))
+	return sdk.NewCoin(reserveOut.Denom, amountOut), fee, true
+}
+
+// Returns the shares of the first deposit of a pool, the geometric mean of the amounts
+func GetInitialShares(amountA sdk.Int, amountB sdk.Int) sdk.Int {
+	product := new(big.Int).Mul(amountA.BigInt(), amountB.BigInt())
+	return sdk.NewIntFromBigInt(new(big.Int).Sqrt(product))
+}
+
+func (pool Pool) String() string {
+	return fmt.Sprintf(`Pool:
+  PoolId:             %d
+  Creator:            %s
+  Reserves:           %s, %s
+  ShareDenom:         %s
+  TotalShares:        %s
+  CreateTime:         %s`, pool.PoolId, pool.Creator, pool.ReserveA, pool.ReserveB,
+		pool.ShareDenom, pool.TotalShares, pool.CreateTime)
+}
+
+// Pools is an array of pool
+type Pools []Pool
+
+func (pools Pools) String() string {
+	out := fmt.Sprintf("%10s - (%30s) - (%30s) - %s\n", "ID", "ReserveA", "ReserveB", "Total Shares")
+	for _, pool := range pools {
+		out += fmt.Sprintf("%10d - (%30s) - (%30s) - %s\n", pool.PoolId, pool.ReserveA, pool.ReserveB, pool.TotalShares)
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// SwapQuote is the result of a swap against the current reserves of a pool
+type SwapQuote struct {
+	PoolId uint64   `json:"pool_id"`
+	Input  sdk.Coin `json:"input"`
+	Output sdk.Coin `json:"output"`
+	Fee    sdk.Coin `json:"fee"`
+	Price  sdk.Dec  `json:"price"`
+}
+
+func (quote SwapQuote) String() string {
+	return fmt.Sprintf(`Swap Quote:
+  PoolId:             %d
+  Input:              %s
+  Output:             %s
+  Fee:                %s
+  Price:              %s`, quote.PoolId, quote.Input, quote.Output, quote.Fee, quote.Price)
+}
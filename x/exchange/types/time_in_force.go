@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Time in force of an order
+const (
+	// good till cancel, the order stays on the book until it is filled or withdrawn
+	GTC = "GTC"
+	// good till time, the order expires at a block time or height
+	GTT = "GTT"
+	// immediate or cancel, the part which is not filled immediately is returned
+	IOC = "IOC"
+	// fill or kill, the order is filled completely or not at all
+	FOK = "FOK"
+)
+
+var TimeInForceTypes = map[string]bool{GTC: true, GTT: true, IOC: true, FOK: true}
+
+type TimeInForce struct {
+	Type         string `json:"type"`
+	ExpireTime   int64  `json:"expire_time"`
+	ExpireHeight int64  `json:"expire_height"`
+}
+
+func NewTimeInForce(tifType string, expireTime int64, expireHeight int64) TimeInForce {
+	return TimeInForce{
+		Type:         tifType,
+		ExpireTime:   expireTime,
+		ExpireHeight: expireHeight,
+	}
+}
+
+// Returns the type of the time in force, GTC if not set
+func (tif TimeInForce) GetType() string {
+	if len(tif.Type) == 0 {
+		return GTC
+	}
+	return tif.Type
+}
+
+func (tif TimeInForce) Validate() error {
+	if !TimeInForceTypes[tif.GetType()] {
+		return fmt.Errorf("unknown time in force: %s", tif.Type)
+	}
+	if tif.ExpireTime < 0 || tif.ExpireHeight < 0 {
+		return fmt.Errorf("expire time and height can not be negative")
+	}
+	if tif.GetType() == GTT {
+		if (tif.ExpireTime > 0) == (tif.ExpireHeight > 0) {
+			return fmt.Errorf("GTT order requires either an expire time or an expire height")
+		}
+	} else if tif.ExpireTime > 0 || tif.ExpireHeight > 0 {
+		return fmt.Errorf("only GTT order can have an expire time or height")
+	}
+	return nil
+}
+
+func (tif TimeInForce) String() string {
+	switch {
+	case tif.ExpireTime > 0:
+		return fmt.Sprintf("%s (expire time %d)", tif.GetType(), tif.ExpireTime)
+	case tif.ExpireHeight > 0:
+		return fmt.Sprintf("%s (expire height %d)", tif.GetType(), tif.ExpireHeight)
+	}
+	return tif.GetType()
+}
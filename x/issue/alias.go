@@ -5,23 +5,34 @@ import (
 	"github.com/hashgard/hashgard/x/issue/client/cli"
 	"github.com/hashgard/hashgard/x/issue/keeper"
 	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/params"
 	"github.com/hashgard/hashgard/x/issue/types"
 )
 
 type (
-	Keeper        = keeper.Keeper
-	CoinIssueInfo = types.CoinIssueInfo
-	Approval      = types.Approval
-	IssueFreeze   = types.IssueFreeze
+	Keeper            = keeper.Keeper
+	FreezeBankKeeper  = keeper.FreezeBankKeeper
+	CoinIssueInfo     = types.CoinIssueInfo
+	Approval          = types.Approval
+	IssueFreeze       = types.IssueFreeze
+	AddressApproval   = types.AddressApproval
+	AddressFreeze     = types.AddressFreeze
+	AddressRole       = types.AddressRole
+	OwnershipTransfer = types.OwnershipTransfer
+	AddressAllowlist  = types.AddressAllowlist
+	MultiSendOutput   = types.MultiSendOutput
+	IssueConfigParams = params.IssueConfigParams
 )
 
 var (
-	MsgCdc          = msgs.MsgCdc
-	NewKeeper       = keeper.NewKeeper
-	NewModuleClient = client.NewModuleClient
-	GetAccountCmd   = cli.GetAccountCmd
-	SendTxCmd       = cli.SendTxCmd
-	RegisterCodec   = msgs.RegisterCodec
+	MsgCdc               = msgs.MsgCdc
+	NewKeeper            = keeper.NewKeeper
+	NewFreezeBankKeeper  = keeper.NewFreezeBankKeeper
+	NewFreezeAnteHandler = keeper.NewFreezeAnteHandler
+	NewModuleClient      = client.NewModuleClient
+	GetAccountCmd        = cli.GetAccountCmd
+	SendTxCmd            = cli.SendTxCmd
+	RegisterCodec        = msgs.RegisterCodec
 )
 
 const (
@@ -11,4 +11,7 @@ const (
 	flagBurnHolderDisabled = "burn-holder"
 	flagBurnFromDisabled   = "burn-from"
 	flagLimit              = "limit"
+	flagAllowlistEnabled   = "allowlist"
+	flagStartAddress       = "start-address"
+	flagBatchSize          = "batch-size"
 )
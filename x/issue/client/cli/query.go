@@ -16,6 +16,27 @@ import (
 	"github.com/spf13/viper"
 )
 
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "Query the issue params",
+		Long:    "Query the fees charged by issue operations",
+		Example: "$ hashgardcli issue params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := issuequeriers.QueryParams(cliCtx)
+			if err != nil {
+				return err
+			}
+			var issueParams params.IssueConfigParams
+			cdc.MustUnmarshalJSON(res, &issueParams)
+			return cliCtx.PrintOutput(issueParams)
+		},
+	}
+}
+
 // GetCmdQueryIssue implements the query issue command.
 func GetCmdQueryIssue(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -107,6 +128,100 @@ func GetCmdQueryFreeze(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdQueryRoles implements the query roles command.
+func GetCmdQueryRoles(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "query-roles [issue-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query roles",
+		Long:    "Query the addresses holding a role of the token",
+		Example: "$ hashgardcli issue query-roles coin174876e800",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			issueID := args[0]
+			if err := issueutils.CheckIssueId(issueID); err != nil {
+				return errors.Errorf(err)
+			}
+			res, err := issuequeriers.QueryIssueRoles(issueID, cliCtx)
+			if err != nil {
+				return err
+			}
+			var roles types.AddressRoles
+			cdc.MustUnmarshalJSON(res, &roles)
+
+			return cliCtx.PrintOutput(roles)
+		},
+	}
+}
+
+// GetCmdQueryAllowlist implements the query allowlist command.
+func GetCmdQueryAllowlist(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "query-allowlist [issue-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query allowlist",
+		Long:    fmt.Sprintf("Query the addresses allowed to receive the token, the limit default and max is %d", types.AllowlistQueryMaxLimit),
+		Example: "$ hashgardcli issue query-allowlist coin174876e800",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			issueID := args[0]
+			if err := issueutils.CheckIssueId(issueID); err != nil {
+				return errors.Errorf(err)
+			}
+			allowlistQueryParams := params.IssueAllowlistQueryParams{
+				IssueId: issueID,
+				Limit:   viper.GetInt(flagLimit),
+			}
+			if len(viper.GetString(flagStartAddress)) > 0 {
+				startAddress, err := sdk.AccAddressFromBech32(viper.GetString(flagStartAddress))
+				if err != nil {
+					return err
+				}
+				allowlistQueryParams.StartAddress = startAddress
+			}
+			res, err := issuequeriers.QueryIssueAllowlist(allowlistQueryParams, cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+			var allowlist types.AddressAllowlists
+			cdc.MustUnmarshalJSON(res, &allowlist)
+
+			return cliCtx.PrintOutput(allowlist)
+		},
+	}
+
+	cmd.Flags().String(flagStartAddress, "", "Start address of allowlist")
+	cmd.Flags().Int32(flagLimit, types.AllowlistQueryMaxLimit, "Query number of allowlist results per page returned")
+
+	return cmd
+}
+
+// GetCmdQueryOwnershipTransfer implements the query pending ownership transfer command.
+func GetCmdQueryOwnershipTransfer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "query-ownership-transfer [issue-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query pending ownership transfer",
+		Long:    "Query the ownership transfer of the token waiting for the new owner to accept it",
+		Example: "$ hashgardcli issue query-ownership-transfer coin174876e800",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			issueID := args[0]
+			if err := issueutils.CheckIssueId(issueID); err != nil {
+				return errors.Errorf(err)
+			}
+			res, err := issuequeriers.QueryIssueOwnershipTransfer(issueID, cliCtx)
+			if err != nil {
+				return err
+			}
+			var transfer types.OwnershipTransfer
+			cdc.MustUnmarshalJSON(res, &transfer)
+
+			return cliCtx.PrintOutput(transfer)
+		},
+	}
+}
+
 // GetCmdQueryIssues implements the query issue command.
 func GetCmdQueryIssues(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
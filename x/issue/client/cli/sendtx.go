@@ -3,8 +3,6 @@ package cli
 import (
 	"fmt"
 
-	"github.com/hashgard/hashgard/x/issue/errors"
-
 	"github.com/hashgard/hashgard/x/issue/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -16,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	boxutils "github.com/hashgard/hashgard/x/box/utils"
 	issuequeriers "github.com/hashgard/hashgard/x/issue/client/queriers"
+	"github.com/hashgard/hashgard/x/issue/errors"
 	issueutils "github.com/hashgard/hashgard/x/issue/utils"
 	"github.com/spf13/cobra"
 )
@@ -50,7 +49,9 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 			for i, coin := range coins {
 				if boxutils.IsBoxId(coin.Denom) {
-					return errors.Errorf(sdk.ErrInternal("box not support yet"))
+					if err = boxutils.CheckBoxTrade(cdc, cliCtx, coin.Denom); err != nil {
+						return err
+					}
 				}
 				if issueutils.IsIssueId(coin.Denom) {
 					res, err := issuequeriers.QueryIssueByID(coin.Denom, cliCtx)
@@ -58,9 +59,15 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 						var issueInfo types.Issue
 						cdc.MustUnmarshalJSON(res, &issueInfo)
 						coins[i].Amount = issueutils.MulDecimals(coin.Amount, issueInfo.GetDecimals())
+						if issueInfo.IsPaused() {
+							return errors.Errorf(errors.ErrIssuePaused(issueInfo.GetIssueId()))
+						}
 						if err = issueutils.CheckFreeze(cdc, cliCtx, issueInfo.GetIssueId(), from, to); err != nil {
 							return err
 						}
+						if err = issueutils.CheckAllowlist(cdc, cliCtx, issueInfo, to); err != nil {
+							return err
+						}
 					}
 				}
 			}
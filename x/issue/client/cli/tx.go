@@ -48,6 +48,7 @@ func GetCmdIssueCreate(cdc *codec.Codec) *cobra.Command {
 				BurnHolderDisabled: viper.GetBool(flagBurnHolderDisabled),
 				BurnFromDisabled:   viper.GetBool(flagBurnFromDisabled),
 				MintingFinished:    viper.GetBool(flagMintingFinished),
+				AllowlistEnabled:   viper.GetBool(flagAllowlistEnabled),
 				TotalSupply:        totalSupply,
 				Decimals:           uint(viper.GetInt(flagDecimals)),
 			}
@@ -68,6 +69,7 @@ func GetCmdIssueCreate(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Bool(flagBurnHolderDisabled, false, "Disable token holder burn the token")
 	cmd.Flags().Bool(flagBurnFromDisabled, false, "Disable token owner burn the token from any holder")
 	cmd.Flags().Bool(flagMintingFinished, false, "Token owner can not minting the token")
+	cmd.Flags().Bool(flagAllowlistEnabled, false, "Only the owner and addresses on the allowlist can receive the token")
 
 	return cmd
 }
@@ -78,7 +80,7 @@ func GetCmdIssueTransferOwnership(cdc *codec.Codec) *cobra.Command {
 		Use:     "transfer-ownership [issue-id] [to_address]",
 		Args:    cobra.ExactArgs(2),
 		Short:   "Transfer ownership a token",
-		Long:    "Token owner transfer the ownership to new account",
+		Long:    "Token owner propose a new owner of the token, the ownership is transferred once the new owner accepts it with accept-ownership",
 		Example: "$ hashgardcli issue transfer-ownership coin174876e800 gard1vf7pnhwh5v4lmdp59dms2andn2hhperghppkxc --from foo",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
@@ -140,7 +142,7 @@ func GetCmdIssueDescription(cdc *codec.Codec) *cobra.Command {
 			}
 			contents = buffer.Bytes()
 
-			_, err = issueutils.IssueOwnerCheck(cdc, cliCtx, account, issueID)
+			_, err = issueutils.IssueRoleCheck(cdc, cliCtx, account, issueID, types.RoleMetadataEditor)
 			if err != nil {
 				return err
 			}
@@ -190,7 +192,7 @@ func GetCmdIssueMint(cdc *codec.Codec) *cobra.Command {
 				}
 			}
 
-			issueInfo, err := issueutils.IssueOwnerCheck(cdc, cliCtx, account, issueID)
+			issueInfo, err := issueutils.IssueRoleCheck(cdc, cliCtx, account, issueID, types.RoleMinter)
 			if err != nil {
 				return err
 			}
@@ -224,13 +226,17 @@ func GetCmdIssueDisableFeature(cdc *codec.Codec) *cobra.Command {
 			"%s:Token holder can burn the token\n"+
 			"%s:Token owner can burn the token from any holder\n"+
 			"%s:Token owner can freeze in and out the token from any address\n"+
-			"%s:Token owner can mint the token", types.BurnOwner, types.BurnHolder, types.BurnFrom, types.Freeze, types.Minting),
+			"%s:Token owner can mint the token\n"+
+			"%s:Only addresses on the allowlist can receive the token\n"+
+			"%s:Token owner can pause all the operations of the token", types.BurnOwner, types.BurnHolder, types.BurnFrom, types.Freeze, types.Minting, types.Allowlist, types.Pause),
 		Example: fmt.Sprintf("$ hashgardcli issue disable coin174876e800 %s --from foo\n"+
+			"$ hashgardcli issue disable coin174876e800 %s  --from foo\n"+
+			"$ hashgardcli issue disable coin174876e800 %s  --from foo\n"+
 			"$ hashgardcli issue disable coin174876e800 %s  --from foo\n"+
 			"$ hashgardcli issue disable coin174876e800 %s  --from foo\n"+
 			"$ hashgardcli issue disable coin174876e800 %s  --from foo\n"+
 			"$ hashgardcli issue disable coin174876e800 %s  --from foo",
-			types.BurnOwner, types.BurnHolder, types.BurnFrom, types.Freeze, types.Minting),
+			types.BurnOwner, types.BurnHolder, types.BurnFrom, types.Freeze, types.Minting, types.Allowlist, types.Pause),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			feature := args[1]
@@ -248,7 +254,7 @@ func GetCmdIssueDisableFeature(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			_, err = issueutils.IssueOwnerCheck(cdc, cliCtx, account, issueID)
+			_, err = issueutils.IssueRoleCheck(cdc, cliCtx, account, issueID, types.RoleAdmin)
 			if err != nil {
 				return err
 			}
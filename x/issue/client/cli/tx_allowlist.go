@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/utils"
+
+	clientutils "github.com/hashgard/hashgard/x/issue/client/utils"
+
+	"github.com/hashgard/hashgard/x/issue/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// GetCmdIssueAllowlistAdd implements add addresses to the allowlist of a token transaction command.
+func GetCmdIssueAllowlistAdd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowlist-add [issue-id] [acc-address]...",
+		Args:  cobra.RangeArgs(2, types.AllowlistBatchMaxLength+1),
+		Short: "Add addresses to the allowlist of a token",
+		Long:  fmt.Sprintf("Token owner or allowlister add up to %d addresses to the allowlist of the token, only addresses on the allowlist can receive the token", types.AllowlistBatchMaxLength),
+		Example: "$ hashgardcli issue allowlist-add coin174876e800 gard15l5yzrq3ff8fl358ng430cc32lzkvxc30n405n --from foo\n" +
+			"$ hashgardcli issue allowlist-add coin174876e800 gard15l5yzrq3ff8fl358ng430cc32lzkvxc30n405n gard1vf7pnhwh5v4lmdp59dms2andn2hhperghppkxc --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issueAllowlist(cdc, args, true)
+		},
+	}
+	return cmd
+}
+
+// GetCmdIssueAllowlistRemove implements remove addresses from the allowlist of a token transaction command.
+func GetCmdIssueAllowlistRemove(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowlist-remove [issue-id] [acc-address]...",
+		Args:    cobra.RangeArgs(2, types.AllowlistBatchMaxLength+1),
+		Short:   "Remove addresses from the allowlist of a token",
+		Long:    fmt.Sprintf("Token owner or allowlister remove up to %d addresses from the allowlist of the token", types.AllowlistBatchMaxLength),
+		Example: "$ hashgardcli issue allowlist-remove coin174876e800 gard15l5yzrq3ff8fl358ng430cc32lzkvxc30n405n --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issueAllowlist(cdc, args, false)
+		},
+	}
+	return cmd
+}
+
+func issueAllowlist(cdc *codec.Codec, args []string, add bool) error {
+
+	txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+	if err != nil {
+		return err
+	}
+	msg, err := clientutils.GetIssueAllowlistMsg(cdc, cliCtx, account, args[0], args[1:], add)
+	if err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+}
@@ -61,6 +61,9 @@ func GetCmdIssueSendFrom(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if err = issueutils.CheckAllowlist(cdc, cliCtx, issueInfo, toAddress); err != nil {
+				return err
+			}
 			amount = issueutils.MulDecimals(amount, issueInfo.GetDecimals())
 
 			msg := msgs.NewMsgIssueSendFrom(issueID, account.GetAddress(), fromAddress, toAddress, amount)
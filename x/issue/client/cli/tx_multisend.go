@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/spf13/viper"
+
+	clientutils "github.com/hashgard/hashgard/x/issue/client/utils"
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// GetCmdIssueMultiSend implements send a token to a batch of recipients transaction command.
+func GetCmdIssueMultiSend(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [issue-id] [recipients-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Send a token to the recipients of a file",
+		Long: fmt.Sprintf("Send a token to the recipients listed in a CSV file of \"address,amount\" lines "+
+			"or in a JSON file of [{\"address\":\"...\",\"amount\":\"...\"}], amounts are in display units with at most the decimals of the token. "+
+			"The recipients are split into transactions of at most %d recipients", types.MultiSendMaxRecipients),
+		Example: "$ hashgardcli issue multi-send coin174876e800 recipients.csv --from foo\n" +
+			"$ hashgardcli issue multi-send coin174876e800 recipients.json --batch-size=50 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputs, err := parseMultiSendOutputs(args[1])
+			if err != nil {
+				return err
+			}
+
+			txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+			if err != nil {
+				return err
+			}
+			batches, err := clientutils.GetIssueMultiSendMsgs(cdc, cliCtx, account, args[0], outputs, viper.GetInt(flagBatchSize))
+			if err != nil {
+				return err
+			}
+
+			// every batch is its own transaction, so the sequence is advanced locally
+			if txBldr.AccountNumber() == 0 {
+				txBldr = txBldr.WithAccountNumber(account.GetAccountNumber())
+			}
+			sequence := txBldr.Sequence()
+			if sequence == 0 {
+				sequence = account.GetSequence()
+			}
+			for i, msg := range batches {
+				if err := utils.GenerateOrBroadcastMsgs(cliCtx, txBldr.WithSequence(sequence+uint64(i)), []sdk.Msg{msg}, false); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagBatchSize, types.MultiSendMaxRecipients, "Maximum number of recipients in a transaction")
+	return cmd
+}
+
+type multiSendRecipient struct {
+	Address string      `json:"address"`
+	Amount  json.Number `json:"amount"`
+}
+
+//Read the recipients from a JSON file or a CSV file with an optional "address,amount" header
+func parseMultiSendOutputs(path string) ([]types.MultiSendOutput, error) {
+	recipients := make([]multiSendRecipient, 0)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(bz, &recipients); err != nil {
+			return nil, err
+		}
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader := csv.NewReader(file)
+		reader.FieldsPerRecord = 2
+		reader.TrimLeadingSpace = true
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		for i, record := range records {
+			if i == 0 && strings.EqualFold(record[0], "address") {
+				continue
+			}
+			recipients = append(recipients, multiSendRecipient{record[0], json.Number(record[1])})
+		}
+	}
+	if len(recipients) == 0 {
+		return nil, errors.Errorf(errors.ErrMultiSendRecipientsNotValid())
+	}
+
+	outputs := make([]types.MultiSendOutput, 0, len(recipients))
+	for _, recipient := range recipients {
+		address, err := sdk.AccAddressFromBech32(strings.TrimSpace(recipient.Address))
+		if err != nil {
+			return nil, err
+		}
+		amount, err := sdk.NewDecFromStr(strings.TrimSpace(recipient.Amount.String()))
+		if err != nil {
+			return nil, fmt.Errorf("Amount %s not a valid number, please input a valid amount", recipient.Amount)
+		}
+		outputs = append(outputs, types.NewMultiSendOutput(address, amount))
+	}
+	return outputs, nil
+}
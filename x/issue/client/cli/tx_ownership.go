@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/utils"
+
+	clientutils "github.com/hashgard/hashgard/x/issue/client/utils"
+
+	"github.com/hashgard/hashgard/x/issue/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// GetCmdIssueAcceptOwnership implements accept the ownership of a token transaction command.
+func GetCmdIssueAcceptOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-ownership [issue-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Accept the ownership of a token",
+		Long:    "The proposed owner accept the pending ownership transfer of the token",
+		Example: "$ hashgardcli issue accept-ownership coin174876e800 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issueOwnership(cdc, args[0], types.TypeMsgIssueAcceptOwnership)
+		},
+	}
+	return cmd
+}
+
+// GetCmdIssueCancelOwnership implements cancel the ownership transfer of a token transaction command.
+func GetCmdIssueCancelOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-ownership [issue-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Cancel the ownership transfer of a token",
+		Long:    "Token owner cancel the pending ownership transfer of the token",
+		Example: "$ hashgardcli issue cancel-ownership coin174876e800 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issueOwnership(cdc, args[0], types.TypeMsgIssueCancelOwnership)
+		},
+	}
+	return cmd
+}
+
+// GetCmdIssueRenounceOwnership implements renounce the ownership of a token transaction command.
+func GetCmdIssueRenounceOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "renounce-ownership [issue-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Renounce the ownership of a token",
+		Long:    "Token owner renounce the ownership and leave the token without owner, minting, burning by owner, burning from any holder and freezing are disabled, roles are revoked, the pause and the allowlist are released and frozen accounts stay frozen until their freezes expire. It can not be undone",
+		Example: "$ hashgardcli issue renounce-ownership coin174876e800 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issueOwnership(cdc, args[0], types.TypeMsgIssueRenounceOwnership)
+		},
+	}
+	return cmd
+}
+
+func issueOwnership(cdc *codec.Codec, issueID string, msgType string) error {
+
+	txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+	if err != nil {
+		return err
+	}
+	msg, err := clientutils.GetIssueOwnershipMsg(cdc, cliCtx, account, issueID, msgType)
+	if err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/utils"
+
+	clientutils "github.com/hashgard/hashgard/x/issue/client/utils"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// GetCmdIssuePause implements pause a token transaction command.
+func GetCmdIssuePause(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause [issue-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Pause a token",
+		Long:    "Token owner or pauser pause the token, all transfers, mints, burns, approvals and exchange orders of the token are rejected until it is unpaused",
+		Example: "$ hashgardcli issue pause coin174876e800 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issuePause(cdc, args[0], true)
+		},
+	}
+	return cmd
+}
+
+// GetCmdIssueUnpause implements unpause a token transaction command.
+func GetCmdIssueUnpause(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause [issue-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Unpause a token",
+		Long:    "Token owner or pauser unpause the paused token",
+		Example: "$ hashgardcli issue unpause coin174876e800 --from foo",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issuePause(cdc, args[0], false)
+		},
+	}
+	return cmd
+}
+
+func issuePause(cdc *codec.Codec, issueID string, pause bool) error {
+
+	txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+	if err != nil {
+		return err
+	}
+	msg, err := clientutils.GetIssuePauseMsg(cdc, cliCtx, account, issueID, pause)
+	if err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+}
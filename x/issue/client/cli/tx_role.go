@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/utils"
+
+	clientutils "github.com/hashgard/hashgard/x/issue/client/utils"
+
+	"github.com/hashgard/hashgard/x/issue/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+var roleHelp = fmt.Sprintf("%s:The address can mint the token\n"+
+	"%s:The address can burn the token from any holder\n"+
+	"%s:The address can freeze and unfreeze the transfer of any address\n"+
+	"%s:The address can describe the token\n"+
+	"%s:The address can add and remove addresses on the allowlist of the token\n"+
+	"%s:The address can pause and unpause the token\n"+
+	"%s:The address holds every role above and can disable features of the token",
+	types.RoleMinter, types.RoleBurner, types.RoleFreezer, types.RoleMetadataEditor, types.RoleAllowlister, types.RolePauser, types.RoleAdmin)
+
+// GetCmdIssueGrantRole implements grant a role of a token transaction command.
+func GetCmdIssueGrantRole(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [role] [issue-id] [acc-address]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Grant a role of the token to a address",
+		Long:  "Token owner grant a role of the token to a address:\n\n" + roleHelp,
+		Example: fmt.Sprintf("$ hashgardcli issue grant-role %s coin174876e800 gard15l5yzrq3ff8fl358ng430cc32lzkvxc30n405n --from foo\n"+
+			"$ hashgardcli issue grant-role %s coin174876e800 gard15l5yzrq3ff8fl358ng430cc32lzkvxc30n405n --from foo",
+			types.RoleMinter, types.RoleAdmin),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issueRole(cdc, args, true)
+		},
+	}
+	return cmd
+}
+
+// GetCmdIssueRevokeRole implements revoke a role of a token transaction command.
+func GetCmdIssueRevokeRole(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [role] [issue-id] [acc-address]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Revoke a role of the token from a address",
+		Long:  "Token owner revoke a role of the token from a address:\n\n" + roleHelp,
+		Example: fmt.Sprintf("$ hashgardcli issue revoke-role %s coin174876e800 gard15l5yzrq3ff8fl358ng430cc32lzkvxc30n405n --from foo",
+			types.RoleMinter),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			return issueRole(cdc, args, false)
+		},
+	}
+	return cmd
+}
+
+func issueRole(cdc *codec.Codec, args []string, grant bool) error {
+
+	txBldr, cliCtx, account, err := clientutils.GetCliContext(cdc)
+	if err != nil {
+		return err
+	}
+	msg, err := clientutils.GetIssueRoleMsg(cdc, cliCtx, account, args[0], args[1], args[2], grant)
+	if err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, false)
+}
@@ -27,10 +27,14 @@ func (mc ModuleClient) GetIssueCmd() *cobra.Command {
 	}
 	issueCmd.AddCommand(
 		client.GetCommands(
+			issueCli.GetCmdQueryParams(mc.cdc),
 			issueCli.GetCmdQueryIssues(mc.cdc),
 			issueCli.GetCmdQueryIssue(mc.cdc),
 			issueCli.GetCmdQueryAllowance(mc.cdc),
 			issueCli.GetCmdQueryFreeze(mc.cdc),
+			issueCli.GetCmdQueryRoles(mc.cdc),
+			issueCli.GetCmdQueryOwnershipTransfer(mc.cdc),
+			issueCli.GetCmdQueryAllowlist(mc.cdc),
 			issueCli.GetCmdSearchIssues(mc.cdc),
 		)...)
 	issueCmd.AddCommand(client.LineBreak)
@@ -44,10 +48,20 @@ func (mc ModuleClient) GetIssueCmd() *cobra.Command {
 		issueCli.GetCmdIssueDecreaseApproval(mc.cdc),
 		issueCli.GetCmdIssueFreeze(mc.cdc),
 		issueCli.GetCmdIssueUnFreeze(mc.cdc),
+		issueCli.GetCmdIssueGrantRole(mc.cdc),
+		issueCli.GetCmdIssueRevokeRole(mc.cdc),
+		issueCli.GetCmdIssueAllowlistAdd(mc.cdc),
+		issueCli.GetCmdIssueAllowlistRemove(mc.cdc),
+		issueCli.GetCmdIssuePause(mc.cdc),
+		issueCli.GetCmdIssueUnpause(mc.cdc),
 		issueCli.GetCmdIssueIncreaseApproval(mc.cdc),
 		issueCli.GetCmdIssueMint(mc.cdc),
 		issueCli.GetCmdIssueSendFrom(mc.cdc),
+		issueCli.GetCmdIssueMultiSend(mc.cdc),
 		issueCli.GetCmdIssueTransferOwnership(mc.cdc),
+		issueCli.GetCmdIssueAcceptOwnership(mc.cdc),
+		issueCli.GetCmdIssueCancelOwnership(mc.cdc),
+		issueCli.GetCmdIssueRenounceOwnership(mc.cdc),
 		client.LineBreak,
 		issueCli.GetCmdIssueDisableFeature(mc.cdc),
 	)
@@ -19,13 +19,29 @@ func GetQueryIssueAllowancePath(issueID string, owner sdk.AccAddress, spender sd
 func GetQueryIssueFreezePath(issueID string, accAddress sdk.AccAddress) string {
 	return fmt.Sprintf("%s/%s/%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryFreeze, issueID, accAddress.String())
 }
+func GetQueryIssueRolesPath(issueID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryRoles, issueID)
+}
+func GetQueryIssueOwnershipTransferPath(issueID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryOwnership, issueID)
+}
+func GetQueryIssueAllowlistPath() string {
+	return fmt.Sprintf("%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryAllowlist)
+}
 func GetQueryIssueSearchPath(symbol string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", types.Custom, types.QuerierRoute, types.QuerySearch, symbol)
 }
+func GetQueryParamsPath() string {
+	return fmt.Sprintf("%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryParams)
+}
 func GetQueryIssuesPath() string {
 	return fmt.Sprintf("%s/%s/%s", types.Custom, types.QuerierRoute, types.QueryIssues)
 }
 
+func QueryParams(cliCtx context.CLIContext) ([]byte, error) {
+	return cliCtx.QueryWithData(GetQueryParamsPath(), nil)
+}
+
 func QueryIssueBySymbol(symbol string, cliCtx context.CLIContext) ([]byte, error) {
 	return cliCtx.QueryWithData(GetQueryIssueSearchPath(symbol), nil)
 }
@@ -39,6 +55,19 @@ func QueryIssueAllowance(issueID string, owner sdk.AccAddress, spender sdk.AccAd
 func QueryIssueFreeze(issueID string, accAddress sdk.AccAddress, cliCtx context.CLIContext) ([]byte, error) {
 	return cliCtx.QueryWithData(GetQueryIssueFreezePath(issueID, accAddress), nil)
 }
+func QueryIssueRoles(issueID string, cliCtx context.CLIContext) ([]byte, error) {
+	return cliCtx.QueryWithData(GetQueryIssueRolesPath(issueID), nil)
+}
+func QueryIssueOwnershipTransfer(issueID string, cliCtx context.CLIContext) ([]byte, error) {
+	return cliCtx.QueryWithData(GetQueryIssueOwnershipTransferPath(issueID), nil)
+}
+func QueryIssueAllowlist(params params.IssueAllowlistQueryParams, cdc *codec.Codec, cliCtx context.CLIContext) ([]byte, error) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	return cliCtx.QueryWithData(GetQueryIssueAllowlistPath(), bz)
+}
 
 func QueryIssuesList(params params.IssueQueryParams, cdc *codec.Codec, cliCtx context.CLIContext) ([]byte, error) {
 	bz, err := cdc.MarshalJSON(params)
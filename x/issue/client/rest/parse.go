@@ -4,4 +4,5 @@ const (
 	restAddress      = "address"
 	restStartIssueId = "start_issue_id"
 	restLimit        = "limit"
+	restStartAddress = "start_address"
 )
@@ -16,6 +16,7 @@ const (
 	Symbol     = "symbol"
 	Amount     = "amount"
 	To         = "to"
+	Role       = "role"
 )
 
 // RegisterRoutes register distribution REST routes.
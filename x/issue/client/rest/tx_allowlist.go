@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"net/http"
+
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+
+	clientutils "github.com/hashgard/hashgard/x/issue/client/utils"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+type PostAllowlistReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	AccAddresses []string     `json:"accAddresses"`
+}
+
+func postIssueAllowlistAddHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return issueAllowlistHandlerFn(cdc, cliCtx, true)
+}
+func postIssueAllowlistRemoveHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return issueAllowlistHandlerFn(cdc, cliCtx, false)
+}
+func issueAllowlistHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, add bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req PostAllowlistReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		account, err := cliCtx.GetAccount(fromAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		vars := mux.Vars(r)
+
+		msg, err := clientutils.GetIssueAllowlistMsg(cdc, cliCtx, account, vars[IssueID], req.AccAddresses, add)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
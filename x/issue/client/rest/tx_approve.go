@@ -59,7 +59,7 @@ func postIssueSendFrom(cdc *codec.Codec, cliCtx context.CLIContext) http.Handler
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		_, err = issueutils.GetIssueByID(cdc, cliCtx, issueID)
+		issueInfo, err := issueutils.GetIssueByID(cdc, cliCtx, issueID)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -74,6 +74,10 @@ func postIssueSendFrom(cdc *codec.Codec, cliCtx context.CLIContext) http.Handler
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
+		if err = issueutils.CheckAllowlist(cdc, cliCtx, issueInfo, to); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
 		msg := msgs.NewMsgIssueSendFrom(issueID, sender, from, to, amount)
 		if err := msg.ValidateBasic(); err != nil {
@@ -0,0 +1,61 @@
+package rest
+
+import (
+	"net/http"
+
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+
+	clientutils "github.com/hashgard/hashgard/x/issue/client/utils"
+	"github.com/hashgard/hashgard/x/issue/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func postAcceptOwnershipHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return issueOwnershipHandlerFn(cdc, cliCtx, types.TypeMsgIssueAcceptOwnership)
+}
+func postCancelOwnershipHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return issueOwnershipHandlerFn(cdc, cliCtx, types.TypeMsgIssueCancelOwnership)
+}
+func postRenounceOwnershipHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return issueOwnershipHandlerFn(cdc, cliCtx, types.TypeMsgIssueRenounceOwnership)
+}
+func issueOwnershipHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, msgType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var req PostIssueBaseReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		account, err := cliCtx.GetAccount(fromAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		vars := mux.Vars(r)
+
+		msg, err := clientutils.GetIssueOwnershipMsg(cdc, cliCtx, account, vars[IssueID], msgType)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
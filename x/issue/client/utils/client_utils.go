@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	issuequeriers "github.com/hashgard/hashgard/x/issue/client/queriers"
 	"github.com/hashgard/hashgard/x/issue/errors"
 	"github.com/hashgard/hashgard/x/issue/types"
 	issueutils "github.com/hashgard/hashgard/x/issue/utils"
@@ -57,9 +58,6 @@ func burnCheck(sender auth.Account, burnFrom sdk.AccAddress, issueInfo types.Iss
 		}
 	case types.BurnFrom:
 		{
-			if !sender.GetAddress().Equals(issueInfo.GetOwner()) {
-				return errors.Errorf(errors.ErrOwnerMismatch(issueInfo.GetIssueId()))
-			}
 			if issueInfo.IsBurnFromDisabled() {
 				return errors.Errorf(errors.ErrCanNotBurn(issueInfo.GetIssueId(), burnType))
 			}
@@ -99,6 +97,11 @@ func GetBurnMsg(cdc *codec.Codec, cliCtx context.CLIContext, sender auth.Account
 			burnFromType = types.BurnOwner
 		}
 	}
+	if types.BurnFrom == burnFromType {
+		if _, err = issueutils.IssueRoleCheck(cdc, cliCtx, sender, issueID, types.RoleBurner); err != nil {
+			return nil, err
+		}
+	}
 	err = burnCheck(sender, burnFrom, issueInfo, amount, burnFromType, cli)
 	if err != nil {
 		return nil, err
@@ -146,7 +149,7 @@ func GetIssueFreezeMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth
 		return nil, err
 	}
 
-	issueInfo, err := issueutils.IssueOwnerCheck(cdc, cliCtx, account, issueID)
+	issueInfo, err := issueutils.IssueRoleCheck(cdc, cliCtx, account, issueID, types.RoleFreezer)
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +176,180 @@ func GetIssueFreezeMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth
 	}
 	return msg, nil
 }
+func GetIssueRoleMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth.Account, role string, issueID string, address string, grant bool) (sdk.Msg, error) {
+
+	_, ok := types.Roles[role]
+	if !ok {
+		return nil, errors.Errorf(errors.ErrUnknownRole(role))
+	}
+
+	if err := issueutils.CheckIssueId(issueID); err != nil {
+		return nil, errors.Errorf(err)
+	}
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = issueutils.IssueOwnerCheck(cdc, cliCtx, account, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+
+	if grant {
+		msg = msgs.NewMsgIssueGrantRole(issueID, account.GetAddress(), accAddress, role)
+	} else {
+		msg = msgs.NewMsgIssueRevokeRole(issueID, account.GetAddress(), accAddress, role)
+	}
+
+	validateErr := msg.ValidateBasic()
+	if validateErr != nil {
+		return nil, errors.Errorf(validateErr)
+	}
+	return msg, nil
+}
+func GetIssueAllowlistMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth.Account, issueID string, addresses []string, add bool) (sdk.Msg, error) {
+	if err := issueutils.CheckIssueId(issueID); err != nil {
+		return nil, errors.Errorf(err)
+	}
+	accAddresses := make([]sdk.AccAddress, 0, len(addresses))
+	for _, address := range addresses {
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		accAddresses = append(accAddresses, accAddress)
+	}
+
+	issueInfo, err := issueutils.IssueRoleCheck(cdc, cliCtx, account, issueID, types.RoleAllowlister)
+	if err != nil {
+		return nil, err
+	}
+	if !issueInfo.IsAllowlistEnabled() {
+		return nil, errors.Errorf(errors.ErrAllowlistDisabled(issueID))
+	}
+
+	var msg sdk.Msg
+
+	if add {
+		msg = msgs.NewMsgIssueAllowlistAdd(issueID, account.GetAddress(), accAddresses)
+	} else {
+		msg = msgs.NewMsgIssueAllowlistRemove(issueID, account.GetAddress(), accAddresses)
+	}
+
+	validateErr := msg.ValidateBasic()
+	if validateErr != nil {
+		return nil, errors.Errorf(validateErr)
+	}
+	return msg, nil
+}
+func GetIssueOwnershipMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth.Account, issueID string, msgType string) (sdk.Msg, error) {
+	if err := issueutils.CheckIssueId(issueID); err != nil {
+		return nil, errors.Errorf(err)
+	}
+	var msg sdk.Msg
+
+	switch msgType {
+	case types.TypeMsgIssueAcceptOwnership:
+		res, err := issuequeriers.QueryIssueOwnershipTransfer(issueID, cliCtx)
+		if err != nil {
+			return nil, err
+		}
+		var transfer types.OwnershipTransfer
+		cdc.MustUnmarshalJSON(res, &transfer)
+		if !transfer.To.Equals(account.GetAddress()) {
+			return nil, errors.Errorf(errors.ErrPendingOwnerMismatch(issueID))
+		}
+		msg = msgs.NewMsgIssueAcceptOwnership(issueID, account.GetAddress())
+	case types.TypeMsgIssueCancelOwnership:
+		if _, err := issueutils.IssueOwnerCheck(cdc, cliCtx, account, issueID); err != nil {
+			return nil, err
+		}
+		if _, err := issuequeriers.QueryIssueOwnershipTransfer(issueID, cliCtx); err != nil {
+			return nil, err
+		}
+		msg = msgs.NewMsgIssueCancelOwnership(issueID, account.GetAddress())
+	case types.TypeMsgIssueRenounceOwnership:
+		if _, err := issueutils.IssueOwnerCheck(cdc, cliCtx, account, issueID); err != nil {
+			return nil, err
+		}
+		msg = msgs.NewMsgIssueRenounceOwnership(issueID, account.GetAddress())
+	default:
+		return nil, sdk.ErrUnknownRequest(msgType)
+	}
+
+	validateErr := msg.ValidateBasic()
+	if validateErr != nil {
+		return nil, errors.Errorf(validateErr)
+	}
+	return msg, nil
+}
+func GetIssuePauseMsg(cdc *codec.Codec, cliCtx context.CLIContext, account auth.Account, issueID string, pause bool) (sdk.Msg, error) {
+	if err := issueutils.CheckIssueId(issueID); err != nil {
+		return nil, errors.Errorf(err)
+	}
+	issueInfo, err := issueutils.IssueRoleCheck(cdc, cliCtx, account, issueID, types.RolePauser)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+
+	if pause {
+		if issueInfo.IsPauseDisabled() {
+			return nil, errors.Errorf(errors.ErrCanNotPause(issueID))
+		}
+		msg = msgs.NewMsgIssuePause(issueID, account.GetAddress())
+	} else {
+		msg = msgs.NewMsgIssueUnpause(issueID, account.GetAddress())
+	}
+
+	validateErr := msg.ValidateBasic()
+	if validateErr != nil {
+		return nil, errors.Errorf(validateErr)
+	}
+	return msg, nil
+}
+func GetIssueMultiSendMsgs(cdc *codec.Codec, cliCtx context.CLIContext, account auth.Account, issueID string, outputs []types.MultiSendOutput, batchSize int) ([]sdk.Msg, error) {
+	if err := issueutils.CheckIssueId(issueID); err != nil {
+		return nil, errors.Errorf(err)
+	}
+	if batchSize <= 0 || batchSize > types.MultiSendMaxRecipients {
+		return nil, errors.Errorf(errors.ErrMultiSendRecipientsNotValid())
+	}
+	issueInfo, err := issueutils.GetIssueByID(cdc, cliCtx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if issueInfo.IsPaused() {
+		return nil, errors.Errorf(errors.ErrIssuePaused(issueID))
+	}
+
+	total := sdk.ZeroDec()
+	for _, output := range outputs {
+		total = total.Add(output.Amount)
+	}
+	balance := sdk.NewDecFromInt(account.GetCoins().AmountOf(issueID))
+	if balance.LT(total.MulInt(issueutils.GetDecimalsInt(issueInfo.GetDecimals()))) {
+		return nil, fmt.Errorf("address %s doesn't have enough coins to pay for this transaction", account.GetAddress())
+	}
+
+	batches := make([]sdk.Msg, 0, (len(outputs)+batchSize-1)/batchSize)
+	for start := 0; start < len(outputs); start += batchSize {
+		end := start + batchSize
+		if end > len(outputs) {
+			end = len(outputs)
+		}
+		msg := msgs.NewMsgIssueMultiSend(issueID, account.GetAddress(), outputs[start:end])
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, errors.Errorf(err)
+		}
+		batches = append(batches, msg)
+	}
+	return batches, nil
+}
 func GetIssueApproveMsg(cdc *codec.Codec, cliCtx context.CLIContext, issueID string, account auth.Account, accAddress sdk.AccAddress, approveType string, amount sdk.Int, cli bool) (sdk.Msg, error) {
 	if err := issueutils.CheckIssueId(issueID); err != nil {
 		return nil, errors.Errorf(err)
@@ -26,6 +26,17 @@ const (
 	CodeFreezeEndTimeNotValid     sdk.CodeType = 15
 	CodeNotTransferIn             sdk.CodeType = 16
 	CodeNotTransferOut            sdk.CodeType = 17
+	CodeRoleMismatch              sdk.CodeType = 18
+	CodeUnknownRole               sdk.CodeType = 19
+	CodeNoOwnershipTransfer       sdk.CodeType = 20
+	CodePendingOwnerMismatch      sdk.CodeType = 21
+	CodeOwnershipTransferNotValid sdk.CodeType = 22
+	CodeNotAllowlisted            sdk.CodeType = 23
+	CodeAllowlistDisabled         sdk.CodeType = 24
+	CodeAllowlistNotValid         sdk.CodeType = 25
+	CodeIssuePaused               sdk.CodeType = 26
+	CodeCanNotPause               sdk.CodeType = 27
+	CodeMultiSendNotValid         sdk.CodeType = 28
 )
 
 //convert sdk.Error to error
@@ -37,6 +48,30 @@ func Errorf(err sdk.Error) error {
 func ErrOwnerMismatch(issueID string) sdk.Error {
 	return sdk.NewError(types.DefaultCodespace, CodeIssuerMismatch, fmt.Sprintf("Owner mismatch with token %s", issueID))
 }
+func ErrRoleMismatch(issueID string, role string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeRoleMismatch, fmt.Sprintf("Sender is neither the owner nor a %s of token %s", role, issueID))
+}
+func ErrUnknownRole(role string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeUnknownRole, fmt.Sprintf("Unknown role %s", role))
+}
+func ErrNoOwnershipTransfer(issueID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeNoOwnershipTransfer, fmt.Sprintf("No pending ownership transfer of token %s", issueID))
+}
+func ErrPendingOwnerMismatch(issueID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodePendingOwnerMismatch, fmt.Sprintf("Pending owner mismatch with token %s", issueID))
+}
+func ErrOwnershipTransferNotValid(issueID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeOwnershipTransferNotValid, fmt.Sprintf("Can not transfer the ownership of token %s to its owner", issueID))
+}
+func ErrNotAllowlisted(issueID string, accAddress string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeNotAllowlisted, fmt.Sprintf("%s is not on the allowlist of token %s", accAddress, issueID))
+}
+func ErrAllowlistDisabled(issueID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeAllowlistDisabled, fmt.Sprintf("Allowlist of token %s is disabled", issueID))
+}
+func ErrAllowlistAddressesNotValid() sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeAllowlistNotValid, fmt.Sprintf("Addresses count must be 1-%d", types.AllowlistBatchMaxLength))
+}
 func ErrCoinDecimalsMaxValueNotValid() sdk.Error {
 	return sdk.NewError(types.DefaultCodespace, CodeIssueCoinDecimalsNotValid, fmt.Sprintf("Decimals max value is %d", types.CoinDecimalsMaxValue))
 }
@@ -91,3 +126,15 @@ func ErrCanNotTransferIn(issueID string, accAddress string) sdk.Error {
 func ErrCanNotTransferOut(issueID string, accAddress string) sdk.Error {
 	return sdk.NewError(types.DefaultCodespace, CodeNotTransferOut, fmt.Sprintf("Can not transfer %s from %s", issueID, accAddress))
 }
+func ErrIssuePaused(issueID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeIssuePaused, fmt.Sprintf("The token %s is paused", issueID))
+}
+func ErrCanNotPause(issueID string) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeCanNotPause, fmt.Sprintf("Can not pause the token %s", issueID))
+}
+func ErrMultiSendRecipientsNotValid() sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeMultiSendNotValid, fmt.Sprintf("Recipients count must be between 1 and %d", types.MultiSendMaxRecipients))
+}
+func ErrMultiSendAmountNotValid(amount sdk.Dec, decimals uint) sdk.Error {
+	return sdk.NewError(types.DefaultCodespace, CodeMultiSendNotValid, fmt.Sprintf("Amount %s has more than %d decimals", amount, decimals))
+}
@@ -2,27 +2,41 @@ package issue
 
 import (
 	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashgard/hashgard/x/issue/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/params"
 )
 
 // GenesisState - all issue state that must be provided at genesis
 type GenesisState struct {
-	StartingIssueId uint64 `json:"starting_issue_id"`
+	StartingIssueId    uint64                   `json:"starting_issue_id"`
+	Params             params.IssueConfigParams `json:"params"`
+	Issues             []CoinIssueInfo          `json:"issues"`
+	Approvals          []AddressApproval        `json:"approvals"`
+	Freezes            []AddressFreeze          `json:"freezes"`
+	Roles              []AddressRole            `json:"roles"`
+	OwnershipTransfers []OwnershipTransfer      `json:"ownership_transfers"`
+	Allowlist          []AddressAllowlist       `json:"allowlist"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(startingIssueId uint64) GenesisState {
-	return GenesisState{startingIssueId}
+func NewGenesisState(startingIssueId uint64, issueParams params.IssueConfigParams) GenesisState {
+	return GenesisState{
+		StartingIssueId: startingIssueId,
+		Params:          issueParams,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(types.CoinIssueMinId)
+	return NewGenesisState(types.CoinIssueMinId, params.IssueConfigParams{})
 }
 
 // Returns if a GenesisState is empty or has data in it
@@ -45,16 +59,178 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) {
 		// TODO: Handle this with #870
 		panic(err)
 	}
+	keeper.SetIssueConfigParams(ctx, data.Params)
+
+	for i := range data.Issues {
+		coinIssueInfo := data.Issues[i]
+		if err := keeper.SetIssue(ctx, &coinIssueInfo); err != nil {
+			panic(err)
+		}
+
+		issueIDs := keeper.GetAddressIssues(ctx, coinIssueInfo.GetIssuer().String())
+		issueIDs = append(issueIDs, coinIssueInfo.IssueId)
+		keeper.SetAddressIssues(ctx, coinIssueInfo.GetIssuer().String(), issueIDs)
+
+		issueIDs = keeper.GetSymbolIssues(ctx, coinIssueInfo.Symbol)
+		issueIDs = append(issueIDs, coinIssueInfo.IssueId)
+		keeper.SetSymbolIssues(ctx, coinIssueInfo.Symbol, issueIDs)
+	}
+
+	for _, approval := range data.Approvals {
+		if err := keeper.SetApprove(ctx, approval.Owner, approval.Spender, approval.IssueId, approval.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, freeze := range data.Freezes {
+		issueFreeze := types.NewIssueFreeze(freeze.OutEndTime, freeze.InEndTime)
+		if err := keeper.SetFreeze(ctx, freeze.IssueId, freeze.Address, issueFreeze); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, role := range data.Roles {
+		keeper.SetRole(ctx, role.IssueId, role.Address, role.Role)
+	}
+
+	for _, transfer := range data.OwnershipTransfers {
+		keeper.SetOwnershipTransfer(ctx, transfer)
+	}
+
+	for _, allowlist := range data.Allowlist {
+		keeper.SetAllowlist(ctx, allowlist.IssueId, allowlist.Address)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) GenesisState {
 	startingIssueId, _ := keeper.PeekCurrentIssueID(ctx)
 	return GenesisState{
-		StartingIssueId: startingIssueId,
+		StartingIssueId:    startingIssueId,
+		Params:             keeper.GetIssueConfigParams(ctx),
+		Issues:             keeper.GetAllIssues(ctx),
+		Approvals:          keeper.GetAllApprovals(ctx),
+		Freezes:            keeper.GetAllFreezes(ctx),
+		Roles:              keeper.GetAllRoles(ctx),
+		OwnershipTransfers: keeper.GetAllOwnershipTransfers(ctx),
+		Allowlist:          keeper.GetAllAllowlists(ctx),
 	}
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	issueIDs := make(map[string]bool, len(data.Issues))
+	owners := make(map[string]sdk.AccAddress, len(data.Issues))
+	for _, coinIssueInfo := range data.Issues {
+		issueID := coinIssueInfo.IssueId
+		if issueIDs[issueID] {
+			return fmt.Errorf("duplicate issue %s found in genesis state", issueID)
+		}
+		issueIDs[issueID] = true
+
+		if !strings.HasPrefix(issueID, types.IDPreStr) {
+			return fmt.Errorf("invalid issue id %s", issueID)
+		}
+		seq, err := strconv.ParseUint(strings.TrimPrefix(issueID, types.IDPreStr), 16, 64)
+		if err != nil {
+			return fmt.Errorf("invalid issue id %s: %s", issueID, err)
+		}
+		if seq < types.CoinIssueMinId || seq > types.CoinIssueMaxId {
+			return fmt.Errorf("issue id %s out of range", issueID)
+		}
+		if seq >= data.StartingIssueId {
+			return fmt.Errorf("issue id %s must be less than starting issue id %d", issueID, data.StartingIssueId)
+		}
+		if coinIssueInfo.Issuer.Empty() {
+			return fmt.Errorf("issue %s has no issuer", issueID)
+		}
+		if coinIssueInfo.Owner.Empty() && !(coinIssueInfo.MintingFinished && coinIssueInfo.BurnOwnerDisabled &&
+			coinIssueInfo.BurnFromDisabled && coinIssueInfo.FreezeDisabled) {
+			return fmt.Errorf("issue %s has no owner but owner features enabled", issueID)
+		}
+		if coinIssueInfo.Paused && (coinIssueInfo.PauseDisabled || coinIssueInfo.Owner.Empty()) {
+			return fmt.Errorf("issue %s is paused but can not be unpaused", issueID)
+		}
+		owners[issueID] = coinIssueInfo.Owner
+		if coinIssueInfo.TotalSupply.IsNegative() {
+			return fmt.Errorf("issue %s has a negative total supply", issueID)
+		}
+	}
+
+	for _, approval := range data.Approvals {
+		if !issueIDs[approval.IssueId] {
+			return fmt.Errorf("approval for unknown issue %s", approval.IssueId)
+		}
+		if approval.Amount.IsNegative() {
+			return fmt.Errorf("negative approval of %s to %s on issue %s", approval.Owner, approval.Spender, approval.IssueId)
+		}
+	}
+
+	for _, freeze := range data.Freezes {
+		if !issueIDs[freeze.IssueId] {
+			return fmt.Errorf("freeze for unknown issue %s", freeze.IssueId)
+		}
+	}
+
+	for _, role := range data.Roles {
+		if !issueIDs[role.IssueId] {
+			return fmt.Errorf("role for unknown issue %s", role.IssueId)
+		}
+		if _, ok := types.Roles[role.Role]; !ok {
+			return fmt.Errorf("unknown role %s of %s on issue %s", role.Role, role.Address, role.IssueId)
+		}
+		if role.Address.Empty() {
+			return fmt.Errorf("role %s on issue %s has no address", role.Role, role.IssueId)
+		}
+	}
+
+	transfers := make(map[string]bool, len(data.OwnershipTransfers))
+	for _, transfer := range data.OwnershipTransfers {
+		if !issueIDs[transfer.IssueId] {
+			return fmt.Errorf("ownership transfer for unknown issue %s", transfer.IssueId)
+		}
+		if transfers[transfer.IssueId] {
+			return fmt.Errorf("duplicate ownership transfer for issue %s", transfer.IssueId)
+		}
+		transfers[transfer.IssueId] = true
+		if owners[transfer.IssueId].Empty() || !owners[transfer.IssueId].Equals(transfer.Owner) {
+			return fmt.Errorf("ownership transfer for issue %s is not proposed by its owner", transfer.IssueId)
+		}
+		if transfer.To.Empty() {
+			return fmt.Errorf("ownership transfer for issue %s has no new owner", transfer.IssueId)
+		}
+	}
+
+	for _, allowlist := range data.Allowlist {
+		if !issueIDs[allowlist.IssueId] {
+			return fmt.Errorf("allowlist for unknown issue %s", allowlist.IssueId)
+		}
+		if allowlist.Address.Empty() {
+			return fmt.Errorf("allowlist of issue %s has an empty address", allowlist.IssueId)
+		}
+	}
+
+	return nil
+}
+
+// ValidateGenesisSupply checks that the total supply of every issue matches the
+// sum of the balances held by the given accounts
+func ValidateGenesisSupply(data GenesisState, balances []sdk.Coins) error {
+	var total sdk.Coins
+	for _, coins := range balances {
+		total = total.Add(coins)
+	}
+	for _, coinIssueInfo := range data.Issues {
+		amount := total.AmountOf(coinIssueInfo.IssueId)
+		if !amount.Equal(coinIssueInfo.TotalSupply) {
+			return fmt.Errorf("total supply of issue %s is %s but accounts hold %s",
+				coinIssueInfo.IssueId, coinIssueInfo.TotalSupply, amount)
+		}
+	}
+	return nil
+}
@@ -18,6 +18,12 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 			return handlers.HandleMsgIssue(ctx, keeper, msg)
 		case msgs.MsgIssueTransferOwnership:
 			return handlers.HandleMsgIssueTransferOwnership(ctx, keeper, msg)
+		case msgs.MsgIssueAcceptOwnership:
+			return handlers.HandleMsgIssueAcceptOwnership(ctx, keeper, msg)
+		case msgs.MsgIssueCancelOwnership:
+			return handlers.HandleMsgIssueCancelOwnership(ctx, keeper, msg)
+		case msgs.MsgIssueRenounceOwnership:
+			return handlers.HandleMsgIssueRenounceOwnership(ctx, keeper, msg)
 		case msgs.MsgIssueDescription:
 			return handlers.HandleMsgIssueDescription(ctx, keeper, msg)
 		case msgs.MsgIssueMint:
@@ -42,6 +48,20 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 			return handlers.HandleMsgIssueFreeze(ctx, keeper, msg)
 		case msgs.MsgIssueUnFreeze:
 			return handlers.HandleMsgIssueUnFreeze(ctx, keeper, msg)
+		case msgs.MsgIssueGrantRole:
+			return handlers.HandleMsgIssueGrantRole(ctx, keeper, msg)
+		case msgs.MsgIssueRevokeRole:
+			return handlers.HandleMsgIssueRevokeRole(ctx, keeper, msg)
+		case msgs.MsgIssueAllowlistAdd:
+			return handlers.HandleMsgIssueAllowlistAdd(ctx, keeper, msg)
+		case msgs.MsgIssueAllowlistRemove:
+			return handlers.HandleMsgIssueAllowlistRemove(ctx, keeper, msg)
+		case msgs.MsgIssuePause:
+			return handlers.HandleMsgIssuePause(ctx, keeper, msg)
+		case msgs.MsgIssueUnpause:
+			return handlers.HandleMsgIssueUnpause(ctx, keeper, msg)
+		case msgs.MsgIssueMultiSend:
+			return handlers.HandleMsgIssueMultiSend(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized gov msg type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/tags"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Handle MsgIssueAcceptOwnership
+func HandleMsgIssueAcceptOwnership(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssueAcceptOwnership) sdk.Result {
+
+	if err := keeper.AcceptOwnership(ctx, msg.IssueId, msg.Sender); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender).AppendTag(tags.Owner, msg.Sender.String()),
+	}
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Handle MsgIssueAllowlistAdd
+func HandleMsgIssueAllowlistAdd(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssueAllowlistAdd) sdk.Result {
+
+	if err := keeper.AddToAllowlist(ctx, msg.IssueId, msg.Sender, msg.AccAddresses); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender),
+	}
+}
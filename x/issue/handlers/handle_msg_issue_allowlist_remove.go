@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Handle MsgIssueAllowlistRemove
+func HandleMsgIssueAllowlistRemove(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssueAllowlistRemove) sdk.Result {
+
+	if err := keeper.RemoveFromAllowlist(ctx, msg.IssueId, msg.Sender, msg.AccAddresses); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender),
+	}
+}
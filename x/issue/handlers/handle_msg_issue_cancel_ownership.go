@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Handle MsgIssueCancelOwnership
+func HandleMsgIssueCancelOwnership(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssueCancelOwnership) sdk.Result {
+
+	if err := keeper.CancelOwnershipTransfer(ctx, msg.IssueId, msg.Sender); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender),
+	}
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Handle MsgIssueMultiSend
+func HandleMsgIssueMultiSend(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssueMultiSend) sdk.Result {
+
+	if err := keeper.MultiSend(ctx, msg.Sender, msg.IssueId, msg.Outputs); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender),
+	}
+}
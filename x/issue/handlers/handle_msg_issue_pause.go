@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Handle MsgIssuePause
+func HandleMsgIssuePause(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssuePause) sdk.Result {
+
+	if err := keeper.Pause(ctx, msg.IssueId, msg.Sender); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender),
+	}
+}
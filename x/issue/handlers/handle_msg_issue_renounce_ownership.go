@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Handle MsgIssueRenounceOwnership
+func HandleMsgIssueRenounceOwnership(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssueRenounceOwnership) sdk.Result {
+
+	if err := keeper.RenounceOwnership(ctx, msg.IssueId, msg.Sender); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender),
+	}
+}
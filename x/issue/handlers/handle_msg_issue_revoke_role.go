@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/tags"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Handle MsgIssueRevokeRole
+func HandleMsgIssueRevokeRole(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssueRevokeRole) sdk.Result {
+
+	if err := keeper.RevokeRole(ctx, msg.GetIssueId(), msg.GetSender(), msg.GetAccAddress(), msg.GetRole()); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender).AppendTag(tags.Role, msg.GetRole()),
+	}
+}
@@ -4,10 +4,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hashgard/hashgard/x/issue/keeper"
 	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/tags"
 	"github.com/hashgard/hashgard/x/issue/utils"
 )
 
-//Handle MsgIssueMint
+//Handle MsgIssueTransferOwnership
 func HandleMsgIssueTransferOwnership(ctx sdk.Context, keeper keeper.Keeper, msg msgs.MsgIssueTransferOwnership) sdk.Result {
 
 	if err := keeper.TransferOwnership(ctx, msg.IssueId, msg.Sender, msg.To); err != nil {
@@ -16,6 +17,6 @@ func HandleMsgIssueTransferOwnership(ctx sdk.Context, keeper keeper.Keeper, msg
 
 	return sdk.Result{
 		Data: keeper.Getcdc().MustMarshalBinaryLengthPrefixed(msg.IssueId),
-		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender),
+		Tags: utils.GetIssueTags(msg.IssueId, msg.Sender).AppendTag(tags.PendingOwner, msg.To.String()),
 	}
 }
@@ -0,0 +1,41 @@
+package issue
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/hashgard/hashgard/x/issue/keeper"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// expected crisis keeper
+type CrisisKeeper interface {
+	RegisterRoute(moduleName, route string, invar sdk.Invariant)
+}
+
+// register issue invariants
+func RegisterInvariants(c CrisisKeeper, keeper keeper.Keeper, ak auth.AccountKeeper) {
+	c.RegisterRoute(types.ModuleName, "total-supply", TotalSupplyInvariant(keeper, ak))
+}
+
+// TotalSupplyInvariant checks that the accounts hold no more coins of every issue than its total supply,
+// coins paid as fees leave the accounts for the fee collector and the distribution pools
+func TotalSupplyInvariant(keeper keeper.Keeper, ak auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		held := sdk.Coins{}
+		ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+			held = held.Add(acc.GetCoins())
+			return false
+		})
+		for _, issue := range keeper.GetAllIssues(ctx) {
+			amount := held.AmountOf(issue.IssueId)
+			if amount.GT(issue.TotalSupply) {
+				return fmt.Errorf("issue %s has total supply %s but accounts hold %s",
+					issue.IssueId, issue.TotalSupply, amount)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/errors"
+	issueparams "github.com/hashgard/hashgard/x/issue/params"
+	"github.com/hashgard/hashgard/x/issue/types"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Set allowlist
+func (keeper Keeper) setAllowlist(ctx sdk.Context, issueID string, accAddress sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyAllowlist(issueID, accAddress), keeper.cdc.MustMarshalBinaryLengthPrefixed(accAddress))
+}
+
+//Set allowlist, used by genesis
+func (keeper Keeper) SetAllowlist(ctx sdk.Context, issueID string, accAddress sdk.AccAddress) {
+	keeper.setAllowlist(ctx, issueID, accAddress)
+}
+
+//Returns whether the address is on the allowlist of an issue
+func (keeper Keeper) IsAllowlisted(ctx sdk.Context, issueID string, accAddress sdk.AccAddress) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(KeyAllowlist(issueID, accAddress))
+}
+
+//Returns a page of the allowlist of an issue starting from the start address
+func (keeper Keeper) GetAllowlist(ctx sdk.Context, params issueparams.IssueAllowlistQueryParams) types.AddressAllowlists {
+	limit := params.Limit
+	if limit <= 0 || limit > types.AllowlistQueryMaxLimit {
+		limit = types.AllowlistQueryMaxLimit
+	}
+	prefix := KeyAllowlists(params.IssueId)
+	start := prefix
+	if !params.StartAddress.Empty() {
+		start = KeyAllowlist(params.IssueId, params.StartAddress)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	allowlist := make(types.AddressAllowlists, 0, limit)
+	for ; iterator.Valid() && len(allowlist) < limit; iterator.Next() {
+		var address sdk.AccAddress
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &address)
+		allowlist = append(allowlist, types.NewAddressAllowlist(params.IssueId, address))
+	}
+	return allowlist
+}
+
+//Returns all allowlists in the store
+func (keeper Keeper) GetAllAllowlists(ctx sdk.Context) types.AddressAllowlists {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyAllowlist())
+	defer iterator.Close()
+
+	allowlist := make(types.AddressAllowlists, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys := strings.Split(string(iterator.Key()), string(KeyDelimiter))
+		var address sdk.AccAddress
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &address)
+		allowlist = append(allowlist, types.NewAddressAllowlist(keys[1], address))
+	}
+	return allowlist
+}
+
+func (keeper Keeper) getIssueByAllowlister(ctx sdk.Context, sender sdk.AccAddress, issueID string) (*types.CoinIssueInfo, sdk.Error) {
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleAllowlister)
+	if err != nil {
+		return nil, err
+	}
+	if !coinIssueInfo.IsAllowlistEnabled() {
+		return nil, errors.ErrAllowlistDisabled(issueID)
+	}
+	return coinIssueInfo, nil
+}
+
+//Owner or allowlister adds addresses to the allowlist of the issue
+func (keeper Keeper) AddToAllowlist(ctx sdk.Context, issueID string, sender sdk.AccAddress, accAddresses []sdk.AccAddress) sdk.Error {
+	if _, err := keeper.getIssueByAllowlister(ctx, sender, issueID); err != nil {
+		return err
+	}
+	for _, accAddress := range accAddresses {
+		keeper.setAllowlist(ctx, issueID, accAddress)
+	}
+	return nil
+}
+
+//Owner or allowlister removes addresses from the allowlist of the issue
+func (keeper Keeper) RemoveFromAllowlist(ctx sdk.Context, issueID string, sender sdk.AccAddress, accAddresses []sdk.AccAddress) sdk.Error {
+	if _, err := keeper.getIssueByAllowlister(ctx, sender, issueID); err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	for _, accAddress := range accAddresses {
+		store.Delete(KeyAllowlist(issueID, accAddress))
+	}
+	return nil
+}
+
+//Check that the address can receive the issue coin, the owner can always receive it
+func (keeper Keeper) CheckAllowlist(ctx sdk.Context, to sdk.AccAddress, issueID string) sdk.Error {
+	coinIssueInfo := keeper.GetIssue(ctx, issueID)
+	if coinIssueInfo == nil || !coinIssueInfo.IsAllowlistEnabled() || to.Equals(coinIssueInfo.GetOwner()) {
+		return nil
+	}
+	if !keeper.IsAllowlisted(ctx, issueID, to) {
+		return errors.ErrNotAllowlisted(issueID, to.String())
+	}
+	return nil
+}
+
+//Check that the address can receive all the issue coins in amt
+func (keeper Keeper) checkAllowlistIn(ctx sdk.Context, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		if !utils.IsIssueId(coin.Denom) {
+			continue
+		}
+		if err := keeper.CheckAllowlist(ctx, to, coin.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// Wraps an ante handler and rejects a tx whose fees or governance deposits are paid in an issue coin
+// that is paused or frozen for the payer. The fees are deducted by the ante handler and the deposits are
+// refunded or burnt by the governance end blocker, both move coins without the freeze bank keeper
+func NewFreezeAnteHandler(ik Keeper, anteHandler sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		if stdTx, ok := tx.(auth.StdTx); ok && len(stdTx.GetSigners()) > 0 {
+			if err := ik.checkTransferOut(ctx, stdTx.GetSigners()[0], stdTx.Fee.Amount); err != nil {
+				return ctx, err.Result(), true
+			}
+		}
+		for _, msg := range tx.GetMsgs() {
+			var err sdk.Error
+			switch msg := msg.(type) {
+			case gov.MsgDeposit:
+				err = ik.checkTransferOut(ctx, msg.Depositor, msg.Amount)
+			case gov.MsgSubmitProposal:
+				err = ik.checkTransferOut(ctx, msg.Proposer, msg.InitialDeposit)
+			}
+			if err != nil {
+				return ctx, err.Result(), true
+			}
+		}
+		return anteHandler(ctx, tx, simulate)
+	}
+}
+
+//Check that none of the issue coins in amt are paused or frozen out of the address
+func (keeper Keeper) checkTransferOut(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if err := keeper.checkPaused(ctx, amt); err != nil {
+		return err
+	}
+	return keeper.checkFreezeOut(ctx, from, amt)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+var _ bank.Keeper = FreezeBankKeeper{}
+
+// Reports whether an address is a pseudo-account derived by a module to hold coins on behalf of its users
+type ModuleAccountChecker func(ctx sdk.Context, addr sdk.AccAddress) bool
+
+// FreezeBankKeeper wraps a bank keeper and rejects any transfer of an issue coin
+// that is paused, frozen for the sender or the receiver, or whose receiver is not on its allowlist
+type FreezeBankKeeper struct {
+	bank.Keeper
+	ik             Keeper
+	moduleAccounts []ModuleAccountChecker
+}
+
+// Module accounts are exempt from the allowlists, the receiver is checked when the coins leave them
+func NewFreezeBankKeeper(bk bank.Keeper, ik Keeper, moduleAccounts ...ModuleAccountChecker) FreezeBankKeeper {
+	return FreezeBankKeeper{
+		Keeper:         bk,
+		ik:             ik,
+		moduleAccounts: moduleAccounts,
+	}
+}
+
+func (keeper FreezeBankKeeper) checkAllowlistIn(ctx sdk.Context, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	for _, isModuleAccount := range keeper.moduleAccounts {
+		if isModuleAccount(ctx, to) {
+			return nil
+		}
+	}
+	return keeper.ik.checkAllowlistIn(ctx, to, amt)
+}
+
+//Send coins after checking the issue pauses, the issue freezes of both sides and the allowlist of the receiver
+func (keeper FreezeBankKeeper) SendCoins(ctx sdk.Context,
+	fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if err := keeper.ik.checkPaused(ctx, amt); err != nil {
+		return err
+	}
+	if err := keeper.ik.checkFreezeOut(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+	if err := keeper.ik.checkFreezeIn(ctx, toAddr, amt); err != nil {
+		return err
+	}
+	if err := keeper.checkAllowlistIn(ctx, toAddr, amt); err != nil {
+		return err
+	}
+	return keeper.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+//Multi send coins after checking the issue pauses, the issue freezes of every input and output and the allowlists of the outputs
+func (keeper FreezeBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error {
+	for _, in := range inputs {
+		if err := keeper.ik.checkPaused(ctx, in.Coins); err != nil {
+			return err
+		}
+		if err := keeper.ik.checkFreezeOut(ctx, in.Address, in.Coins); err != nil {
+			return err
+		}
+	}
+	for _, out := range outputs {
+		if err := keeper.ik.checkFreezeIn(ctx, out.Address, out.Coins); err != nil {
+			return err
+		}
+		if err := keeper.checkAllowlistIn(ctx, out.Address, out.Coins); err != nil {
+			return err
+		}
+	}
+	return keeper.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
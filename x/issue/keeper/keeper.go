@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -34,6 +35,8 @@ type Keeper struct {
 	storeKey sdk.StoreKey
 	// The reference to the CoinKeeper to modify balances
 	ck BankKeeper
+	// The reference to the FeeCollectionKeeper to collect issue fees
+	fk FeeCollectionKeeper
 	// The codec codec for binary encoding/decoding.
 	cdc *codec.Codec
 	// Reserved codespace
@@ -47,12 +50,13 @@ func (keeper Keeper) Getcdc() *codec.Codec {
 
 //New issue keeper Instance
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
-	paramSpace params.Subspace, ck BankKeeper, codespace sdk.CodespaceType) Keeper {
+	paramSpace params.Subspace, ck BankKeeper, fk FeeCollectionKeeper, codespace sdk.CodespaceType) Keeper {
 	return Keeper{
 		storeKey:     key,
 		paramsKeeper: paramsKeeper,
 		paramSpace:   paramSpace.WithKeyTable(ParamKeyTable()),
 		ck:           ck,
+		fk:           fk,
 		cdc:          cdc,
 		codespace:    codespace,
 	}
@@ -94,6 +98,90 @@ func (keeper Keeper) setApprove(ctx sdk.Context, sender sdk.AccAddress, spender
 	return nil
 }
 
+//Set approve, used by genesis
+func (keeper Keeper) SetApprove(ctx sdk.Context, sender sdk.AccAddress, spender sdk.AccAddress, issueID string, amount sdk.Int) sdk.Error {
+	return keeper.setApprove(ctx, sender, spender, issueID, amount)
+}
+
+//Set issue, used by genesis
+func (keeper Keeper) SetIssue(ctx sdk.Context, coinIssueInfo *types.CoinIssueInfo) sdk.Error {
+	return keeper.setIssue(ctx, coinIssueInfo)
+}
+
+//Set address issues, used by genesis
+func (keeper Keeper) SetAddressIssues(ctx sdk.Context, accAddress string, issueIDs []string) {
+	keeper.setAddressIssues(ctx, accAddress, issueIDs)
+}
+
+//Set symbol issues, used by genesis
+func (keeper Keeper) SetSymbolIssues(ctx sdk.Context, symbol string, issueIDs []string) {
+	keeper.setSymbolIssues(ctx, symbol, issueIDs)
+}
+
+//Set freeze, used by genesis
+func (keeper Keeper) SetFreeze(ctx sdk.Context, issueID string, accAddress sdk.AccAddress, freeze types.IssueFreeze) sdk.Error {
+	return keeper.setFreeze(ctx, issueID, accAddress, freeze)
+}
+
+//Returns all issues in the store
+func (keeper Keeper) GetAllIssues(ctx sdk.Context) []types.CoinIssueInfo {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyIssuer())
+	defer iterator.Close()
+
+	issues := make([]types.CoinIssueInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var coinIssueInfo types.CoinIssueInfo
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &coinIssueInfo)
+		issues = append(issues, coinIssueInfo)
+	}
+	return issues
+}
+
+//Returns all allowances in the store
+func (keeper Keeper) GetAllApprovals(ctx sdk.Context) []types.AddressApproval {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyAllowed())
+	defer iterator.Close()
+
+	approvals := make([]types.AddressApproval, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys := strings.Split(string(iterator.Key()), string(KeyDelimiter))
+		owner, err := sdk.AccAddressFromBech32(keys[2])
+		if err != nil {
+			panic(err)
+		}
+		spender, err := sdk.AccAddressFromBech32(keys[3])
+		if err != nil {
+			panic(err)
+		}
+		var amount sdk.Int
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		approvals = append(approvals, types.NewAddressApproval(keys[1], owner, spender, amount))
+	}
+	return approvals
+}
+
+//Returns all freezes in the store
+func (keeper Keeper) GetAllFreezes(ctx sdk.Context) []types.AddressFreeze {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyFreeze())
+	defer iterator.Close()
+
+	freezes := make([]types.AddressFreeze, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys := strings.Split(string(iterator.Key()), string(KeyDelimiter))
+		address, err := sdk.AccAddressFromBech32(keys[2])
+		if err != nil {
+			panic(err)
+		}
+		var freeze types.IssueFreeze
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &freeze)
+		freezes = append(freezes, types.NewAddressFreeze(keys[1], address, freeze))
+	}
+	return freezes
+}
+
 //Returns issue by issueID
 func (keeper Keeper) GetIssue(ctx sdk.Context, issueID string) *types.CoinIssueInfo {
 	store := ctx.KVStore(keeper.storeKey)
@@ -179,6 +267,9 @@ func (keeper Keeper) List(ctx sdk.Context, params issueparams.IssueQueryParams)
 
 //Add a issue
 func (keeper Keeper) AddIssue(ctx sdk.Context, coinIssueInfo *types.CoinIssueInfo) (sdk.Coins, sdk.Error) {
+	if err := keeper.chargeFee(ctx, coinIssueInfo.Issuer, keeper.GetIssueConfigParams(ctx).MinDeposit); err != nil {
+		return nil, err
+	}
 	store := ctx.KVStore(keeper.storeKey)
 	id, err := keeper.getNewIssueID(store)
 	if err != nil {
@@ -217,7 +308,7 @@ func (keeper Keeper) getIssueByOwner(ctx sdk.Context, sender sdk.AccAddress, iss
 }
 
 func (keeper Keeper) finishMinting(ctx sdk.Context, sender sdk.AccAddress, issueID string) sdk.Error {
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleAdmin)
 	if err != nil {
 		return err
 	}
@@ -243,6 +334,10 @@ func (keeper Keeper) DisableFeature(ctx sdk.Context, sender sdk.AccAddress, issu
 		return keeper.disableFreeze(ctx, sender, issueID)
 	case types.Minting:
 		return keeper.finishMinting(ctx, sender, issueID)
+	case types.Allowlist:
+		return keeper.disableAllowlist(ctx, sender, issueID)
+	case types.Pause:
+		return keeper.disablePause(ctx, sender, issueID)
 	default:
 		return errors.ErrUnknownFeatures()
 	}
@@ -250,7 +345,7 @@ func (keeper Keeper) DisableFeature(ctx sdk.Context, sender sdk.AccAddress, issu
 }
 
 func (keeper Keeper) disableBurnOwner(ctx sdk.Context, sender sdk.AccAddress, issueID string) sdk.Error {
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleAdmin)
 	if err != nil {
 		return err
 	}
@@ -264,7 +359,7 @@ func (keeper Keeper) disableBurnOwner(ctx sdk.Context, sender sdk.AccAddress, is
 }
 
 func (keeper Keeper) disableBurnHolder(ctx sdk.Context, sender sdk.AccAddress, issueID string) sdk.Error {
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleAdmin)
 	if err != nil {
 		return err
 	}
@@ -278,7 +373,7 @@ func (keeper Keeper) disableBurnHolder(ctx sdk.Context, sender sdk.AccAddress, i
 }
 
 func (keeper Keeper) disableFreeze(ctx sdk.Context, sender sdk.AccAddress, issueID string) sdk.Error {
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleAdmin)
 	if err != nil {
 		return err
 	}
@@ -292,7 +387,7 @@ func (keeper Keeper) disableFreeze(ctx sdk.Context, sender sdk.AccAddress, issue
 }
 
 func (keeper Keeper) disableBurnFrom(ctx sdk.Context, sender sdk.AccAddress, issueID string) sdk.Error {
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleAdmin)
 	if err != nil {
 		return err
 	}
@@ -305,6 +400,36 @@ func (keeper Keeper) disableBurnFrom(ctx sdk.Context, sender sdk.AccAddress, iss
 	return keeper.setIssue(ctx, coinIssueInfo)
 }
 
+func (keeper Keeper) disableAllowlist(ctx sdk.Context, sender sdk.AccAddress, issueID string) sdk.Error {
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if !coinIssueInfo.IsAllowlistEnabled() {
+		return nil
+	}
+	coinIssueInfo.AllowlistEnabled = false
+
+	return keeper.setIssue(ctx, coinIssueInfo)
+}
+
+//Disabling the pause feature also lifts a pause in effect, the issue can never be paused again
+func (keeper Keeper) disablePause(ctx sdk.Context, sender sdk.AccAddress, issueID string) sdk.Error {
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if coinIssueInfo.IsPauseDisabled() {
+		return nil
+	}
+	coinIssueInfo.PauseDisabled = true
+	coinIssueInfo.Paused = false
+
+	return keeper.setIssue(ctx, coinIssueInfo)
+}
+
 //Can mint a coin
 func (keeper Keeper) CanMint(ctx sdk.Context, issueID string) bool {
 	coinIssueInfo := keeper.GetIssue(ctx, issueID)
@@ -314,7 +439,7 @@ func (keeper Keeper) CanMint(ctx sdk.Context, issueID string) bool {
 //Mint a coin
 func (keeper Keeper) Mint(ctx sdk.Context, issueID string, amount sdk.Int, sender sdk.AccAddress, to sdk.AccAddress) (sdk.Coins, sdk.Error) {
 
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleMinter)
 
 	if err != nil {
 		return nil, err
@@ -323,9 +448,18 @@ func (keeper Keeper) Mint(ctx sdk.Context, issueID string, amount sdk.Int, sende
 	if coinIssueInfo.IsMintingFinished() {
 		return nil, errors.ErrCanNotMint(issueID)
 	}
+	if coinIssueInfo.IsPaused() {
+		return nil, errors.ErrIssuePaused(issueID)
+	}
 	if utils.QuoDecimals(coinIssueInfo.TotalSupply.Add(amount), coinIssueInfo.Decimals).GT(types.CoinMaxTotalSupply) {
 		return nil, errors.ErrCoinTotalSupplyMaxValueNotValid()
 	}
+	if err := keeper.CheckAllowlist(ctx, to, issueID); err != nil {
+		return nil, err
+	}
+	if err := keeper.chargeFee(ctx, sender, keeper.GetIssueConfigParams(ctx).MintFee); err != nil {
+		return nil, err
+	}
 
 	coin := sdk.Coin{Denom: coinIssueInfo.IssueId, Amount: amount}
 	coins, err := keeper.ck.AddCoins(ctx, to, sdk.NewCoins(coin))
@@ -363,6 +497,9 @@ func (keeper Keeper) BurnHolder(ctx sdk.Context, issueID string, amount sdk.Int,
 	return keeper.burn(ctx, coinIssueInfo, amount, sender)
 }
 func (keeper Keeper) burn(ctx sdk.Context, coinIssueInfo *types.CoinIssueInfo, amount sdk.Int, who sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	if coinIssueInfo.IsPaused() {
+		return nil, errors.ErrIssuePaused(coinIssueInfo.IssueId)
+	}
 	coin := sdk.Coin{Denom: coinIssueInfo.IssueId, Amount: amount}
 	coins, err := keeper.ck.SubtractCoins(ctx, who, sdk.NewCoins(coin))
 	if err != nil {
@@ -376,7 +513,7 @@ func (keeper Keeper) burn(ctx sdk.Context, coinIssueInfo *types.CoinIssueInfo, a
 
 func (keeper Keeper) BurnFrom(ctx sdk.Context, issueID string, amount sdk.Int, sender sdk.AccAddress, who sdk.AccAddress) (sdk.Coins, sdk.Error) {
 
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleBurner)
 	if err != nil {
 		return nil, err
 	}
@@ -418,7 +555,7 @@ func (keeper Keeper) freeze(ctx sdk.Context, issueID string, sender sdk.AccAddre
 
 }
 func (keeper Keeper) Freeze(ctx sdk.Context, issueID string, sender sdk.AccAddress, accAddress sdk.AccAddress, freezeType string, endTime int64) sdk.Error {
-	issueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	issueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleFreezer)
 	if err != nil {
 		return err
 	}
@@ -426,10 +563,13 @@ func (keeper Keeper) Freeze(ctx sdk.Context, issueID string, sender sdk.AccAddre
 		return errors.ErrCanNotFreeze(issueID)
 
 	}
+	if err := keeper.chargeFee(ctx, sender, keeper.GetIssueConfigParams(ctx).FreezeFee); err != nil {
+		return err
+	}
 	return keeper.freeze(ctx, issueID, sender, accAddress, freezeType, endTime)
 }
 func (keeper Keeper) UnFreeze(ctx sdk.Context, issueID string, sender sdk.AccAddress, accAddress sdk.AccAddress, freezeType string) sdk.Error {
-	_, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	_, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleFreezer)
 	if err != nil {
 		return err
 	}
@@ -458,43 +598,54 @@ func (keeper Keeper) freezeInAndOut(ctx sdk.Context, issueID string, accAddress
 }
 
 func (keeper Keeper) SetIssueDescription(ctx sdk.Context, issueID string, sender sdk.AccAddress, description []byte) sdk.Error {
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RoleMetadataEditor)
 
 	if err != nil {
 		return err
 	}
+	if err := keeper.chargeFee(ctx, sender, keeper.GetIssueConfigParams(ctx).DescribeFee); err != nil {
+		return err
+	}
 
 	coinIssueInfo.Description = string(description)
 
 	return keeper.setIssue(ctx, coinIssueInfo)
 }
 
-//TransferOwnership
-func (keeper Keeper) TransferOwnership(ctx sdk.Context, issueID string, sender sdk.AccAddress, to sdk.AccAddress) sdk.Error {
-	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
-
-	if err != nil {
+//Check that the coin is a known issue which is not paused, approvals only cover issue coins as other coins
+//such as box certificates would bypass the rules of their own bank keeper
+func (keeper Keeper) checkApprovable(ctx sdk.Context, issueID string) sdk.Error {
+	if err := utils.CheckIssueId(issueID); err != nil {
 		return err
 	}
-
-	coinIssueInfo.Owner = to
-
-	return keeper.setIssue(ctx, coinIssueInfo)
+	if keeper.GetIssue(ctx, issueID) == nil {
+		return errors.ErrUnknownIssue(issueID)
+	}
+	return keeper.CheckPaused(ctx, issueID)
 }
 
 // Approve the passed address to spend the specified amount of tokens on behalf of sender
 func (keeper Keeper) Approve(ctx sdk.Context, sender sdk.AccAddress, spender sdk.AccAddress, issueID string, amount sdk.Int) sdk.Error {
+	if err := keeper.checkApprovable(ctx, issueID); err != nil {
+		return err
+	}
 	return keeper.setApprove(ctx, sender, spender, issueID, amount)
 }
 
 //Increase the amount of tokens that an owner allowed to a spender
 func (keeper Keeper) IncreaseApproval(ctx sdk.Context, sender sdk.AccAddress, spender sdk.AccAddress, issueID string, addedValue sdk.Int) sdk.Error {
+	if err := keeper.checkApprovable(ctx, issueID); err != nil {
+		return err
+	}
 	allowance := keeper.Allowance(ctx, sender, spender, issueID)
 	return keeper.setApprove(ctx, sender, spender, issueID, allowance.Add(addedValue))
 }
 
 //Decrease the amount of tokens that an owner allowed to a spender
 func (keeper Keeper) DecreaseApproval(ctx sdk.Context, sender sdk.AccAddress, spender sdk.AccAddress, issueID string, subtractedValue sdk.Int) sdk.Error {
+	if err := keeper.checkApprovable(ctx, issueID); err != nil {
+		return err
+	}
 	allowance := keeper.Allowance(ctx, sender, spender, issueID)
 	allowance = allowance.Sub(subtractedValue)
 	if allowance.LT(sdk.ZeroInt()) {
@@ -505,20 +656,20 @@ func (keeper Keeper) DecreaseApproval(ctx sdk.Context, sender sdk.AccAddress, sp
 
 //Transfer tokens from one address to another
 func (keeper Keeper) SendFrom(ctx sdk.Context, sender sdk.AccAddress, from sdk.AccAddress, to sdk.AccAddress, issueID string, amount sdk.Int) sdk.Error {
+	if err := keeper.checkApprovable(ctx, issueID); err != nil {
+		return err
+	}
 
 	allowance := keeper.Allowance(ctx, from, sender, issueID)
 	if allowance.LT(amount) {
 		return errors.ErrNotEnoughAmountToTransfer()
 	}
 
-	freeze := keeper.GetFreeze(ctx, from, issueID)
-	if checkErr := utils.CheckFreezeByOut(issueID, freeze, from); checkErr != nil {
-		return checkErr
+	if err := keeper.CheckFreeze(ctx, from, to, issueID); err != nil {
+		return err
 	}
-
-	freeze = keeper.GetFreeze(ctx, to, issueID)
-	if checkErr := utils.CheckFreezeByIn(issueID, freeze, to); checkErr != nil {
-		return checkErr
+	if err := keeper.CheckAllowlist(ctx, to, issueID); err != nil {
+		return err
 	}
 
 	err := keeper.SendCoins(ctx, from, to, sdk.Coins{sdk.NewCoin(issueID, amount)})
@@ -529,6 +680,87 @@ func (keeper Keeper) SendFrom(ctx sdk.Context, sender sdk.AccAddress, from sdk.A
 	return keeper.Approve(ctx, from, sender, issueID, allowance.Sub(amount))
 }
 
+//Send the issue coin from sender to every recipient, amounts are in display units and converted with the decimals once,
+//an amount more precise than the decimals is rejected
+func (keeper Keeper) MultiSend(ctx sdk.Context, sender sdk.AccAddress, issueID string, outputs []types.MultiSendOutput) sdk.Error {
+	coinIssueInfo := keeper.GetIssue(ctx, issueID)
+	if coinIssueInfo == nil {
+		return errors.ErrUnknownIssue(issueID)
+	}
+	if coinIssueInfo.IsPaused() {
+		return errors.ErrIssuePaused(issueID)
+	}
+
+	decimals := utils.GetDecimalsInt(coinIssueInfo.Decimals)
+	amounts := make([]sdk.Int, len(outputs))
+	total := sdk.ZeroInt()
+	for i, output := range outputs {
+		if err := keeper.CheckFreeze(ctx, sender, output.Address, issueID); err != nil {
+			return err
+		}
+		if err := keeper.CheckAllowlist(ctx, output.Address, issueID); err != nil {
+			return err
+		}
+		amount := output.Amount.MulInt(decimals)
+		amounts[i] = amount.TruncateInt()
+		if !amount.Equal(sdk.NewDecFromInt(amounts[i])) {
+			return errors.ErrMultiSendAmountNotValid(output.Amount, coinIssueInfo.Decimals)
+		}
+		total = total.Add(amounts[i])
+	}
+
+	if _, err := keeper.ck.SubtractCoins(ctx, sender, sdk.NewCoins(sdk.NewCoin(issueID, total))); err != nil {
+		return err
+	}
+	for i, output := range outputs {
+		if _, err := keeper.ck.AddCoins(ctx, output.Address, sdk.NewCoins(sdk.NewCoin(issueID, amounts[i]))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Check that the issue coin is not frozen for either side of a transfer
+func (keeper Keeper) CheckFreeze(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, issueID string) sdk.Error {
+	now := ctx.BlockHeader().Time
+
+	freeze := keeper.GetFreeze(ctx, from, issueID)
+	if err := utils.CheckFreezeByOut(issueID, freeze, from, now); err != nil {
+		return err
+	}
+
+	freeze = keeper.GetFreeze(ctx, to, issueID)
+	return utils.CheckFreezeByIn(issueID, freeze, to, now)
+}
+
+//Check that none of the issue coins in amt are frozen out of the address
+func (keeper Keeper) checkFreezeOut(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		if !utils.IsIssueId(coin.Denom) {
+			continue
+		}
+		freeze := keeper.GetFreeze(ctx, from, coin.Denom)
+		if err := utils.CheckFreezeByOut(coin.Denom, freeze, from, ctx.BlockHeader().Time); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Check that none of the issue coins in amt are frozen into the address
+func (keeper Keeper) checkFreezeIn(ctx sdk.Context, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		if !utils.IsIssueId(coin.Denom) {
+			continue
+		}
+		freeze := keeper.GetFreeze(ctx, to, coin.Denom)
+		if err := utils.CheckFreezeByIn(coin.Denom, freeze, to, ctx.BlockHeader().Time); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 //Send coins
 func (keeper Keeper) SendCoins(ctx sdk.Context,
 	fromAddr sdk.AccAddress, toAddr sdk.AccAddress,
@@ -577,6 +809,19 @@ func (keeper Keeper) GetIssueConfigParams(ctx sdk.Context) issueparams.IssueConf
 	return issueConfigParams
 }
 
+//Charge the fee of an issue operation from sender to the fee collector
+func (keeper Keeper) chargeFee(ctx sdk.Context, sender sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	if fee.IsZero() {
+		return nil
+	}
+	_, err := keeper.ck.SubtractCoins(ctx, sender, fee)
+	if err != nil {
+		return err
+	}
+	keeper.fk.AddCollectedFees(ctx, fee)
+	return nil
+}
+
 //Set issueConfigParams
 func (keeper Keeper) SetIssueConfigParams(ctx sdk.Context, issueConfigParams issueparams.IssueConfigParams) {
 	keeper.paramSpace.Set(ctx, ParamStoreKeyIssueParams, &issueConfigParams)
@@ -34,10 +34,45 @@ func KeyAllowed(issueID string, sender sdk.AccAddress, spender sdk.AccAddress) [
 func KeyFreeze(issueID string, accAddress sdk.AccAddress) []byte {
 	return []byte(fmt.Sprintf("freeze:%s:%s", issueID, accAddress.String()))
 }
+func KeyRole(issueID string, accAddress sdk.AccAddress, role string) []byte {
+	return []byte(fmt.Sprintf("role:%s:%s:%s", issueID, accAddress.String(), role))
+}
+func KeyRoles(issueID string) []byte {
+	return []byte(fmt.Sprintf("role:%s:", issueID))
+}
+func KeyOwnershipTransfer(issueID string) []byte {
+	return []byte(fmt.Sprintf("ownership:%s", issueID))
+}
+func KeyAllowlist(issueID string, accAddress sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("allowlist:%s:%s", issueID, accAddress.String()))
+}
+func KeyAllowlists(issueID string) []byte {
+	return []byte(fmt.Sprintf("allowlist:%s:", issueID))
+}
 func KeySymbolIssues(symbol string) []byte {
 	return []byte(fmt.Sprintf("symbol:%s", strings.ToUpper(symbol)))
 }
 
+// Key prefixes for iterating all records of a kind
+func PrefixKeyIssuer() []byte {
+	return []byte("issues:")
+}
+func PrefixKeyAllowed() []byte {
+	return []byte("allowed:")
+}
+func PrefixKeyFreeze() []byte {
+	return []byte("freeze:")
+}
+func PrefixKeyRole() []byte {
+	return []byte("role:")
+}
+func PrefixKeyAllowlist() []byte {
+	return []byte("allowlist:")
+}
+func PrefixKeyOwnershipTransfer() []byte {
+	return []byte("ownership:")
+}
+
 func KeyIssueIdStr(seq uint64) string {
 
 	return fmt.Sprintf("%s%x", types.IDPreStr, seq)
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+//Set ownership transfer
+func (keeper Keeper) setOwnershipTransfer(ctx sdk.Context, transfer types.OwnershipTransfer) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyOwnershipTransfer(transfer.IssueId), keeper.cdc.MustMarshalBinaryLengthPrefixed(transfer))
+}
+
+//Set ownership transfer, used by genesis
+func (keeper Keeper) SetOwnershipTransfer(ctx sdk.Context, transfer types.OwnershipTransfer) {
+	keeper.setOwnershipTransfer(ctx, transfer)
+}
+
+//Remove ownership transfer
+func (keeper Keeper) removeOwnershipTransfer(ctx sdk.Context, issueID string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyOwnershipTransfer(issueID))
+}
+
+//Returns the pending ownership transfer of an issue
+func (keeper Keeper) GetOwnershipTransfer(ctx sdk.Context, issueID string) *types.OwnershipTransfer {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyOwnershipTransfer(issueID))
+	if len(bz) == 0 {
+		return nil
+	}
+	var transfer types.OwnershipTransfer
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &transfer)
+	return &transfer
+}
+
+//Returns all pending ownership transfers in the store
+func (keeper Keeper) GetAllOwnershipTransfers(ctx sdk.Context) []types.OwnershipTransfer {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, PrefixKeyOwnershipTransfer())
+	defer iterator.Close()
+
+	transfers := make([]types.OwnershipTransfer, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.OwnershipTransfer
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+//Owner proposes a new owner, the ownership changes once the new owner accepts it
+func (keeper Keeper) TransferOwnership(ctx sdk.Context, issueID string, sender sdk.AccAddress, to sdk.AccAddress) sdk.Error {
+	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+
+	if err != nil {
+		return err
+	}
+	if coinIssueInfo.Owner.Equals(to) {
+		return errors.ErrOwnershipTransferNotValid(issueID)
+	}
+	if err := keeper.chargeFee(ctx, sender, keeper.GetIssueConfigParams(ctx).TransferOwnerFee); err != nil {
+		return err
+	}
+
+	keeper.setOwnershipTransfer(ctx, types.NewOwnershipTransfer(issueID, sender, to))
+
+	return nil
+}
+
+//Proposed owner accepts the pending ownership transfer
+func (keeper Keeper) AcceptOwnership(ctx sdk.Context, issueID string, sender sdk.AccAddress) sdk.Error {
+	coinIssueInfo := keeper.GetIssue(ctx, issueID)
+	if coinIssueInfo == nil {
+		return errors.ErrUnknownIssue(issueID)
+	}
+	transfer := keeper.GetOwnershipTransfer(ctx, issueID)
+	if transfer == nil {
+		return errors.ErrNoOwnershipTransfer(issueID)
+	}
+	if !transfer.To.Equals(sender) {
+		return errors.ErrPendingOwnerMismatch(issueID)
+	}
+
+	coinIssueInfo.Owner = sender
+	keeper.removeOwnershipTransfer(ctx, issueID)
+
+	return keeper.setIssue(ctx, coinIssueInfo)
+}
+
+//Owner cancels the pending ownership transfer
+func (keeper Keeper) CancelOwnershipTransfer(ctx sdk.Context, issueID string, sender sdk.AccAddress) sdk.Error {
+	if _, err := keeper.getIssueByOwner(ctx, sender, issueID); err != nil {
+		return err
+	}
+	if keeper.GetOwnershipTransfer(ctx, issueID) == nil {
+		return errors.ErrNoOwnershipTransfer(issueID)
+	}
+
+	keeper.removeOwnershipTransfer(ctx, issueID)
+
+	return nil
+}
+
+//Owner renounces the ownership, the issue is left ownerless with all owner features disabled,
+//roles are revoked and a pause and the allowlist are released as nobody could lift them anymore.
+//Existing freezes are kept, every freeze has an end time and is lifted when it expires
+func (keeper Keeper) RenounceOwnership(ctx sdk.Context, issueID string, sender sdk.AccAddress) sdk.Error {
+	coinIssueInfo, err := keeper.getIssueByOwner(ctx, sender, issueID)
+	if err != nil {
+		return err
+	}
+
+	coinIssueInfo.Owner = nil
+	coinIssueInfo.MintingFinished = true
+	coinIssueInfo.BurnOwnerDisabled = true
+	coinIssueInfo.BurnFromDisabled = true
+	coinIssueInfo.FreezeDisabled = true
+	coinIssueInfo.PauseDisabled = true
+	coinIssueInfo.Paused = false
+	coinIssueInfo.AllowlistEnabled = false
+
+	keeper.removeOwnershipTransfer(ctx, issueID)
+
+	store := ctx.KVStore(keeper.storeKey)
+	for _, role := range keeper.GetRoles(ctx, issueID) {
+		store.Delete(KeyRole(issueID, role.Address, role.Role))
+	}
+
+	return keeper.setIssue(ctx, coinIssueInfo)
+}
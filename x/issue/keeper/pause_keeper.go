@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+	"github.com/hashgard/hashgard/x/issue/utils"
+)
+
+//Owner or pauser pauses the issue, transfers, mints, burns and approvals of the coin are rejected until it is unpaused
+func (keeper Keeper) Pause(ctx sdk.Context, issueID string, sender sdk.AccAddress) sdk.Error {
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RolePauser)
+	if err != nil {
+		return err
+	}
+	if coinIssueInfo.IsPauseDisabled() {
+		return errors.ErrCanNotPause(issueID)
+	}
+	if coinIssueInfo.IsPaused() {
+		return nil
+	}
+	coinIssueInfo.Paused = true
+
+	return keeper.setIssue(ctx, coinIssueInfo)
+}
+
+//Owner or pauser unpauses the issue
+func (keeper Keeper) Unpause(ctx sdk.Context, issueID string, sender sdk.AccAddress) sdk.Error {
+	coinIssueInfo, err := keeper.getIssueByRole(ctx, sender, issueID, types.RolePauser)
+	if err != nil {
+		return err
+	}
+	if !coinIssueInfo.IsPaused() {
+		return nil
+	}
+	coinIssueInfo.Paused = false
+
+	return keeper.setIssue(ctx, coinIssueInfo)
+}
+
+//Check that the issue coin is not paused
+func (keeper Keeper) CheckPaused(ctx sdk.Context, issueID string) sdk.Error {
+	coinIssueInfo := keeper.GetIssue(ctx, issueID)
+	if coinIssueInfo != nil && coinIssueInfo.IsPaused() {
+		return errors.ErrIssuePaused(issueID)
+	}
+	return nil
+}
+
+//Check that none of the issue coins in amt are paused
+func (keeper Keeper) checkPaused(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		if !utils.IsIssueId(coin.Denom) {
+			continue
+		}
+		if err := keeper.CheckPaused(ctx, coin.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+//Set role
+func (keeper Keeper) setRole(ctx sdk.Context, issueID string, accAddress sdk.AccAddress, role string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyRole(issueID, accAddress, role), keeper.cdc.MustMarshalBinaryLengthPrefixed(role))
+}
+
+//Set role, used by genesis
+func (keeper Keeper) SetRole(ctx sdk.Context, issueID string, accAddress sdk.AccAddress, role string) {
+	keeper.setRole(ctx, issueID, accAddress, role)
+}
+
+//Returns whether the address holds the role or the admin role of an issue
+func (keeper Keeper) HasRole(ctx sdk.Context, issueID string, accAddress sdk.AccAddress, role string) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(KeyRole(issueID, accAddress, role)) || store.Has(KeyRole(issueID, accAddress, types.RoleAdmin))
+}
+
+//Returns the role holders of an issue
+func (keeper Keeper) GetRoles(ctx sdk.Context, issueID string) types.AddressRoles {
+	return keeper.getRolesByPrefix(ctx, KeyRoles(issueID))
+}
+
+//Returns all roles in the store
+func (keeper Keeper) GetAllRoles(ctx sdk.Context) types.AddressRoles {
+	return keeper.getRolesByPrefix(ctx, PrefixKeyRole())
+}
+
+func (keeper Keeper) getRolesByPrefix(ctx sdk.Context, prefix []byte) types.AddressRoles {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	roles := make(types.AddressRoles, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys := strings.Split(string(iterator.Key()), string(KeyDelimiter))
+		address, err := sdk.AccAddressFromBech32(keys[2])
+		if err != nil {
+			panic(err)
+		}
+		var role string
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &role)
+		roles = append(roles, types.NewAddressRole(keys[1], address, role))
+	}
+	return roles
+}
+
+//Owner grants a role of the issue to an address
+func (keeper Keeper) GrantRole(ctx sdk.Context, issueID string, sender sdk.AccAddress, accAddress sdk.AccAddress, role string) sdk.Error {
+	if _, ok := types.Roles[role]; !ok {
+		return errors.ErrUnknownRole(role)
+	}
+	if _, err := keeper.getIssueByOwner(ctx, sender, issueID); err != nil {
+		return err
+	}
+	keeper.setRole(ctx, issueID, accAddress, role)
+	return nil
+}
+
+//Owner revokes a role of the issue from an address
+func (keeper Keeper) RevokeRole(ctx sdk.Context, issueID string, sender sdk.AccAddress, accAddress sdk.AccAddress, role string) sdk.Error {
+	if _, ok := types.Roles[role]; !ok {
+		return errors.ErrUnknownRole(role)
+	}
+	if _, err := keeper.getIssueByOwner(ctx, sender, issueID); err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyRole(issueID, accAddress, role))
+	return nil
+}
+
+//Returns the issue if the sender is its owner or holds the role
+func (keeper Keeper) getIssueByRole(ctx sdk.Context, sender sdk.AccAddress, issueID string, role string) (*types.CoinIssueInfo, sdk.Error) {
+	coinIssueInfo := keeper.GetIssue(ctx, issueID)
+	if coinIssueInfo == nil {
+		return nil, errors.ErrUnknownIssue(issueID)
+	}
+	if !coinIssueInfo.Owner.Equals(sender) && !keeper.HasRole(ctx, issueID, sender, role) {
+		return nil, errors.ErrRoleMismatch(issueID, role)
+	}
+	return coinIssueInfo, nil
+}
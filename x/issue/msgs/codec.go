@@ -12,6 +12,9 @@ var MsgCdc = codec.New()
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgIssue{}, "issue/MsgIssue", nil)
 	cdc.RegisterConcrete(MsgIssueTransferOwnership{}, "issue/MsgIssueTransferOwnership", nil)
+	cdc.RegisterConcrete(MsgIssueAcceptOwnership{}, "issue/MsgIssueAcceptOwnership", nil)
+	cdc.RegisterConcrete(MsgIssueCancelOwnership{}, "issue/MsgIssueCancelOwnership", nil)
+	cdc.RegisterConcrete(MsgIssueRenounceOwnership{}, "issue/MsgIssueRenounceOwnership", nil)
 	cdc.RegisterConcrete(MsgIssueDescription{}, "issue/MsgIssueDescription", nil)
 	cdc.RegisterConcrete(MsgIssueMint{}, "issue/MsgIssueMint", nil)
 	cdc.RegisterConcrete(MsgIssueBurnOwner{}, "issue/MsgIssueBurnOwner", nil)
@@ -24,6 +27,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgIssueDecreaseApproval{}, "issue/MsgIssueDecreaseApproval", nil)
 	cdc.RegisterConcrete(MsgIssueFreeze{}, "issue/MsgIssueFreeze", nil)
 	cdc.RegisterConcrete(MsgIssueUnFreeze{}, "issue/MsgIssueUnFreeze", nil)
+	cdc.RegisterConcrete(MsgIssueGrantRole{}, "issue/MsgIssueGrantRole", nil)
+	cdc.RegisterConcrete(MsgIssueRevokeRole{}, "issue/MsgIssueRevokeRole", nil)
+	cdc.RegisterConcrete(MsgIssueAllowlistAdd{}, "issue/MsgIssueAllowlistAdd", nil)
+	cdc.RegisterConcrete(MsgIssueAllowlistRemove{}, "issue/MsgIssueAllowlistRemove", nil)
+	cdc.RegisterConcrete(MsgIssuePause{}, "issue/MsgIssuePause", nil)
+	cdc.RegisterConcrete(MsgIssueUnpause{}, "issue/MsgIssueUnpause", nil)
+	cdc.RegisterConcrete(MsgIssueMultiSend{}, "issue/MsgIssueMultiSend", nil)
 
 	cdc.RegisterInterface((*types.Issue)(nil), nil)
 	cdc.RegisterConcrete(&types.CoinIssueInfo{}, "issue/CoinIssueInfo", nil)
@@ -0,0 +1,50 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueAcceptOwnership to allow the proposed owner
+// to accept the pending ownership transfer of a token.
+type MsgIssueAcceptOwnership struct {
+	IssueId string         `json:"issue_id"`
+	Sender  sdk.AccAddress `json:"sender"`
+}
+
+//New MsgIssueAcceptOwnership Instance
+func NewMsgIssueAcceptOwnership(issueId string, sender sdk.AccAddress) MsgIssueAcceptOwnership {
+	return MsgIssueAcceptOwnership{issueId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueAcceptOwnership) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueAcceptOwnership) Type() string { return types.TypeMsgIssueAcceptOwnership }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgIssueAcceptOwnership) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueAcceptOwnership) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueAcceptOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueAcceptOwnership) String() string {
+	return fmt.Sprintf("MsgIssueAcceptOwnership{%s}", msg.IssueId)
+}
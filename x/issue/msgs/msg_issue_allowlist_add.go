@@ -0,0 +1,60 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueAllowlistAdd to allow a registered owner or allowlister
+// to add a batch of addresses to the allowlist of a token.
+type MsgIssueAllowlistAdd struct {
+	IssueId      string           `json:"issue_id"`
+	Sender       sdk.AccAddress   `json:"sender"`
+	AccAddresses []sdk.AccAddress `json:"accAddresses"`
+}
+
+//New MsgIssueAllowlistAdd Instance
+func NewMsgIssueAllowlistAdd(issueId string, sender sdk.AccAddress, accAddresses []sdk.AccAddress) MsgIssueAllowlistAdd {
+	return MsgIssueAllowlistAdd{issueId, sender, accAddresses}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueAllowlistAdd) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueAllowlistAdd) Type() string { return types.TypeMsgIssueAllowlistAdd }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgIssueAllowlistAdd) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	if len(msg.AccAddresses) == 0 || len(msg.AccAddresses) > types.AllowlistBatchMaxLength {
+		return errors.ErrAllowlistAddressesNotValid()
+	}
+	for _, accAddress := range msg.AccAddresses {
+		if len(accAddress) == 0 {
+			return sdk.ErrInvalidAddress("AccAddress cannot be empty")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueAllowlistAdd) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueAllowlistAdd) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueAllowlistAdd) String() string {
+	return fmt.Sprintf("MsgIssueAllowlistAdd{%s - %d}", msg.IssueId, len(msg.AccAddresses))
+}
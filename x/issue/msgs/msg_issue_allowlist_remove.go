@@ -0,0 +1,60 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueAllowlistRemove to allow a registered owner or allowlister
+// to remove a batch of addresses from the allowlist of a token.
+type MsgIssueAllowlistRemove struct {
+	IssueId      string           `json:"issue_id"`
+	Sender       sdk.AccAddress   `json:"sender"`
+	AccAddresses []sdk.AccAddress `json:"accAddresses"`
+}
+
+//New MsgIssueAllowlistRemove Instance
+func NewMsgIssueAllowlistRemove(issueId string, sender sdk.AccAddress, accAddresses []sdk.AccAddress) MsgIssueAllowlistRemove {
+	return MsgIssueAllowlistRemove{issueId, sender, accAddresses}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueAllowlistRemove) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueAllowlistRemove) Type() string { return types.TypeMsgIssueAllowlistRemove }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgIssueAllowlistRemove) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	if len(msg.AccAddresses) == 0 || len(msg.AccAddresses) > types.AllowlistBatchMaxLength {
+		return errors.ErrAllowlistAddressesNotValid()
+	}
+	for _, accAddress := range msg.AccAddresses {
+		if len(accAddress) == 0 {
+			return sdk.ErrInvalidAddress("AccAddress cannot be empty")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueAllowlistRemove) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueAllowlistRemove) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueAllowlistRemove) String() string {
+	return fmt.Sprintf("MsgIssueAllowlistRemove{%s - %d}", msg.IssueId, len(msg.AccAddresses))
+}
@@ -0,0 +1,50 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueCancelOwnership to allow a registered owner
+// to cancel the pending ownership transfer of a token.
+type MsgIssueCancelOwnership struct {
+	IssueId string         `json:"issue_id"`
+	Sender  sdk.AccAddress `json:"sender"`
+}
+
+//New MsgIssueCancelOwnership Instance
+func NewMsgIssueCancelOwnership(issueId string, sender sdk.AccAddress) MsgIssueCancelOwnership {
+	return MsgIssueCancelOwnership{issueId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueCancelOwnership) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueCancelOwnership) Type() string { return types.TypeMsgIssueCancelOwnership }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgIssueCancelOwnership) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueCancelOwnership) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueCancelOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueCancelOwnership) String() string {
+	return fmt.Sprintf("MsgIssueCancelOwnership{%s}", msg.IssueId)
+}
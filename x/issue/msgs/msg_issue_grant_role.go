@@ -0,0 +1,84 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueGrantRole to allow a registered owner to grant a role of the issue to an address
+type MsgIssueGrantRole struct {
+	IssueId    string         `json:"issue_id"`
+	Sender     sdk.AccAddress `json:"sender"`
+	AccAddress sdk.AccAddress `json:"accAddress"`
+	Role       string         `json:"role"`
+}
+
+//New MsgIssueGrantRole Instance
+func NewMsgIssueGrantRole(issueId string, sender sdk.AccAddress, accAddress sdk.AccAddress, role string) MsgIssueGrantRole {
+	return MsgIssueGrantRole{issueId, sender, accAddress, role}
+}
+
+//nolint
+func (ci MsgIssueGrantRole) GetIssueId() string {
+	return ci.IssueId
+}
+func (ci MsgIssueGrantRole) SetIssueId(issueId string) {
+	ci.IssueId = issueId
+}
+func (ci MsgIssueGrantRole) GetSender() sdk.AccAddress {
+	return ci.Sender
+}
+func (ci MsgIssueGrantRole) SetSender(sender sdk.AccAddress) {
+	ci.Sender = sender
+}
+func (ci MsgIssueGrantRole) GetAccAddress() sdk.AccAddress {
+	return ci.AccAddress
+}
+func (ci MsgIssueGrantRole) SetAccAddress(accAddress sdk.AccAddress) {
+	ci.AccAddress = accAddress
+}
+func (ci MsgIssueGrantRole) GetRole() string {
+	return ci.Role
+}
+func (ci MsgIssueGrantRole) SetRole(role string) {
+	ci.Role = role
+}
+
+// Route Implements Msg.
+func (msg MsgIssueGrantRole) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueGrantRole) Type() string { return types.TypeMsgIssueGrantRole }
+
+// Implements Msg. Ensures addresses and role are valid
+func (msg MsgIssueGrantRole) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	if len(msg.AccAddress) == 0 {
+		return sdk.ErrInvalidAddress("AccAddress cannot be empty")
+	}
+	if _, ok := types.Roles[msg.Role]; !ok {
+		return errors.ErrUnknownRole(msg.Role)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueGrantRole) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueGrantRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueGrantRole) String() string {
+	return fmt.Sprintf("MsgIssueGrantRole{%s}", msg.IssueId)
+}
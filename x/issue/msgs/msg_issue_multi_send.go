@@ -0,0 +1,66 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueMultiSend to allow a token holder
+// to send the token to a batch of recipients.
+type MsgIssueMultiSend struct {
+	IssueId string                  `json:"issue_id"`
+	Sender  sdk.AccAddress          `json:"sender"`
+	Outputs []types.MultiSendOutput `json:"outputs"`
+}
+
+//New MsgIssueMultiSend Instance
+func NewMsgIssueMultiSend(issueId string, sender sdk.AccAddress, outputs []types.MultiSendOutput) MsgIssueMultiSend {
+	return MsgIssueMultiSend{issueId, sender, outputs}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueMultiSend) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueMultiSend) Type() string { return types.TypeMsgIssueMultiSend }
+
+// Implements Msg. Ensures addresses are valid and amounts are positive
+func (msg MsgIssueMultiSend) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	if len(msg.Outputs) == 0 || len(msg.Outputs) > types.MultiSendMaxRecipients {
+		return errors.ErrMultiSendRecipientsNotValid()
+	}
+	for _, output := range msg.Outputs {
+		if len(output.Address) == 0 {
+			return sdk.ErrInvalidAddress("Address cannot be empty")
+		}
+		if output.Amount.IsNil() || !output.Amount.IsPositive() {
+			return sdk.ErrInvalidCoins("Cannot send 0 or negative coin amounts")
+		}
+		if output.Amount.GT(sdk.NewDecFromInt(types.CoinMaxTotalSupply)) {
+			return errors.ErrCoinTotalSupplyMaxValueNotValid()
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueMultiSend) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueMultiSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueMultiSend) String() string {
+	return fmt.Sprintf("MsgIssueMultiSend{%s - %d}", msg.IssueId, len(msg.Outputs))
+}
@@ -0,0 +1,50 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssuePause to allow the owner or a pauser
+// to pause all the operations of a token.
+type MsgIssuePause struct {
+	IssueId string         `json:"issue_id"`
+	Sender  sdk.AccAddress `json:"sender"`
+}
+
+//New MsgIssuePause Instance
+func NewMsgIssuePause(issueId string, sender sdk.AccAddress) MsgIssuePause {
+	return MsgIssuePause{issueId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgIssuePause) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssuePause) Type() string { return types.TypeMsgIssuePause }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgIssuePause) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssuePause) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssuePause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssuePause) String() string {
+	return fmt.Sprintf("MsgIssuePause{%s}", msg.IssueId)
+}
@@ -0,0 +1,50 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueRenounceOwnership to allow a registered owner
+// to leave a token ownerless.
+type MsgIssueRenounceOwnership struct {
+	IssueId string         `json:"issue_id"`
+	Sender  sdk.AccAddress `json:"sender"`
+}
+
+//New MsgIssueRenounceOwnership Instance
+func NewMsgIssueRenounceOwnership(issueId string, sender sdk.AccAddress) MsgIssueRenounceOwnership {
+	return MsgIssueRenounceOwnership{issueId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueRenounceOwnership) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueRenounceOwnership) Type() string { return types.TypeMsgIssueRenounceOwnership }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgIssueRenounceOwnership) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueRenounceOwnership) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueRenounceOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueRenounceOwnership) String() string {
+	return fmt.Sprintf("MsgIssueRenounceOwnership{%s}", msg.IssueId)
+}
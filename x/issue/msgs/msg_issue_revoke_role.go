@@ -0,0 +1,84 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueRevokeRole to allow a registered owner to revoke a role of the issue from an address
+type MsgIssueRevokeRole struct {
+	IssueId    string         `json:"issue_id"`
+	Sender     sdk.AccAddress `json:"sender"`
+	AccAddress sdk.AccAddress `json:"accAddress"`
+	Role       string         `json:"role"`
+}
+
+//New MsgIssueRevokeRole Instance
+func NewMsgIssueRevokeRole(issueId string, sender sdk.AccAddress, accAddress sdk.AccAddress, role string) MsgIssueRevokeRole {
+	return MsgIssueRevokeRole{issueId, sender, accAddress, role}
+}
+
+//nolint
+func (ci MsgIssueRevokeRole) GetIssueId() string {
+	return ci.IssueId
+}
+func (ci MsgIssueRevokeRole) SetIssueId(issueId string) {
+	ci.IssueId = issueId
+}
+func (ci MsgIssueRevokeRole) GetSender() sdk.AccAddress {
+	return ci.Sender
+}
+func (ci MsgIssueRevokeRole) SetSender(sender sdk.AccAddress) {
+	ci.Sender = sender
+}
+func (ci MsgIssueRevokeRole) GetAccAddress() sdk.AccAddress {
+	return ci.AccAddress
+}
+func (ci MsgIssueRevokeRole) SetAccAddress(accAddress sdk.AccAddress) {
+	ci.AccAddress = accAddress
+}
+func (ci MsgIssueRevokeRole) GetRole() string {
+	return ci.Role
+}
+func (ci MsgIssueRevokeRole) SetRole(role string) {
+	ci.Role = role
+}
+
+// Route Implements Msg.
+func (msg MsgIssueRevokeRole) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueRevokeRole) Type() string { return types.TypeMsgIssueRevokeRole }
+
+// Implements Msg. Ensures addresses and role are valid
+func (msg MsgIssueRevokeRole) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	if len(msg.AccAddress) == 0 {
+		return sdk.ErrInvalidAddress("AccAddress cannot be empty")
+	}
+	if _, ok := types.Roles[msg.Role]; !ok {
+		return errors.ErrUnknownRole(msg.Role)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueRevokeRole) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueRevokeRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueRevokeRole) String() string {
+	return fmt.Sprintf("MsgIssueRevokeRole{%s}", msg.IssueId)
+}
@@ -9,7 +9,7 @@ import (
 )
 
 // MsgIssueTransferOwnership to allow a registered owner
-// to issue new coins.
+// to propose a new owner of a token.
 type MsgIssueTransferOwnership struct {
 	IssueId string         `json:"issue_id"`
 	Sender  sdk.AccAddress `json:"sender"`
@@ -32,6 +32,9 @@ func (msg MsgIssueTransferOwnership) ValidateBasic() sdk.Error {
 	if len(msg.IssueId) == 0 {
 		return sdk.ErrInvalidAddress("IssueId cannot be empty")
 	}
+	if len(msg.To) == 0 {
+		return sdk.ErrInvalidAddress("To cannot be empty")
+	}
 	return nil
 }
 
@@ -0,0 +1,50 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+// MsgIssueUnpause to allow the owner or a pauser
+// to unpause a paused token.
+type MsgIssueUnpause struct {
+	IssueId string         `json:"issue_id"`
+	Sender  sdk.AccAddress `json:"sender"`
+}
+
+//New MsgIssueUnpause Instance
+func NewMsgIssueUnpause(issueId string, sender sdk.AccAddress) MsgIssueUnpause {
+	return MsgIssueUnpause{issueId, sender}
+}
+
+// Route Implements Msg.
+func (msg MsgIssueUnpause) Route() string { return types.RouterKey }
+
+// Type Implements Msg.
+func (msg MsgIssueUnpause) Type() string { return types.TypeMsgIssueUnpause }
+
+// Implements Msg. Ensures addresses are valid
+func (msg MsgIssueUnpause) ValidateBasic() sdk.Error {
+	if len(msg.IssueId) == 0 {
+		return sdk.ErrInvalidAddress("IssueId cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgIssueUnpause) GetSignBytes() []byte {
+	bz := MsgCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgIssueUnpause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgIssueUnpause) String() string {
+	return fmt.Sprintf("MsgIssueUnpause{%s}", msg.IssueId)
+}
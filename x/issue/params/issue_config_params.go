@@ -8,17 +8,48 @@ import (
 
 // Param Config issue for issue
 type IssueConfigParams struct {
-	MinDeposit      sdk.Coins `json:"min_deposit"`
-	StartingIssueId uint64    `json:"starting_issue_id"`
+	MinDeposit       sdk.Coins `json:"min_deposit"`
+	MintFee          sdk.Coins `json:"mint_fee"`
+	FreezeFee        sdk.Coins `json:"freeze_fee"`
+	DescribeFee      sdk.Coins `json:"describe_fee"`
+	TransferOwnerFee sdk.Coins `json:"transfer_owner_fee"`
+	StartingIssueId  uint64    `json:"starting_issue_id"`
 }
 
 func (dp IssueConfigParams) String() string {
-	return fmt.Sprintf(`Issue Params:Min Deposit:%s`, dp.MinDeposit)
+	return fmt.Sprintf(`Issue Params:
+  Issue Fee:			%s
+  Mint Fee:			%s
+  Freeze Fee:			%s
+  Describe Fee:			%s
+  Transfer Owner Fee:		%s`,
+		dp.MinDeposit, dp.MintFee, dp.FreezeFee, dp.DescribeFee, dp.TransferOwnerFee)
 }
 
 // Checks equality of IssueConfigParams
 func (dp IssueConfigParams) Equal(dp2 IssueConfigParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit)
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) &&
+		dp.MintFee.IsEqual(dp2.MintFee) &&
+		dp.FreezeFee.IsEqual(dp2.FreezeFee) &&
+		dp.DescribeFee.IsEqual(dp2.DescribeFee) &&
+		dp.TransferOwnerFee.IsEqual(dp2.TransferOwnerFee)
+}
+
+// Returns an error if any of the fees is not a valid set of coins
+func (dp IssueConfigParams) Validate() error {
+	fees := map[string]sdk.Coins{
+		"min_deposit":        dp.MinDeposit,
+		"mint_fee":           dp.MintFee,
+		"freeze_fee":         dp.FreezeFee,
+		"describe_fee":       dp.DescribeFee,
+		"transfer_owner_fee": dp.TransferOwnerFee,
+	}
+	for name, fee := range fees {
+		if !fee.IsValid() {
+			return fmt.Errorf("invalid issue param %s: %s", name, fee)
+		}
+	}
+	return nil
 }
 
 // Params returns all of the issue params
@@ -10,3 +10,10 @@ type IssueQueryParams struct {
 	Owner        sdk.AccAddress `json:"owner"`
 	Limit        int            `json:"limit"`
 }
+
+// Param query allowlist for issue
+type IssueAllowlistQueryParams struct {
+	IssueId      string         `json:"issue_id"`
+	StartAddress sdk.AccAddress `json:"start_address"`
+	Limit        int            `json:"limit"`
+}
@@ -19,8 +19,16 @@ func NewQuerier(keeper keeper.Keeper) sdk.Querier {
 			return queriers.QueryAllowance(ctx, path[1], path[2], path[3], keeper)
 		case types.QueryFreeze:
 			return queriers.QueryFreeze(ctx, path[1], path[2], keeper)
+		case types.QueryRoles:
+			return queriers.QueryRoles(ctx, path[1], keeper)
+		case types.QueryAllowlist:
+			return queriers.QueryAllowlist(ctx, req, keeper)
+		case types.QueryOwnership:
+			return queriers.QueryOwnershipTransfer(ctx, path[1], keeper)
 		case types.QuerySearch:
 			return queriers.QuerySymbol(ctx, path[1], keeper)
+		case types.QueryParams:
+			return queriers.QueryParams(ctx, keeper)
 		case types.QueryIssues:
 			return queriers.QueryIssues(ctx, req, keeper)
 		default:
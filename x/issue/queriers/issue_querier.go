@@ -51,6 +51,41 @@ func QueryFreeze(ctx sdk.Context, issueID string, accAddress string, keeper keep
 	}
 	return bz, nil
 }
+func QueryRoles(ctx sdk.Context, issueID string, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	roles := keeper.GetRoles(ctx, issueID)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Getcdc(), roles)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+func QueryAllowlist(ctx sdk.Context, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params params.IssueAllowlistQueryParams
+	err := keeper.Getcdc().UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	allowlist := keeper.GetAllowlist(ctx, params)
+	bz, err := codec.MarshalJSONIndent(keeper.Getcdc(), allowlist)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+func QueryOwnershipTransfer(ctx sdk.Context, issueID string, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	transfer := keeper.GetOwnershipTransfer(ctx, issueID)
+	if transfer == nil {
+		return nil, errors.ErrNoOwnershipTransfer(issueID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.Getcdc(), transfer)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
 func QuerySymbol(ctx sdk.Context, symbol string, keeper keeper.Keeper) ([]byte, sdk.Error) {
 	issue := keeper.SearchIssues(ctx, symbol)
 	if issue == nil {
@@ -63,6 +98,15 @@ func QuerySymbol(ctx sdk.Context, symbol string, keeper keeper.Keeper) ([]byte,
 	}
 	return bz, nil
 }
+func QueryParams(ctx sdk.Context, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	issueParams := keeper.GetIssueConfigParams(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Getcdc(), issueParams)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
 func QueryIssues(ctx sdk.Context, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
 	var params params.IssueQueryParams
 	err := keeper.Getcdc().UnmarshalJSON(req.Data, &params)
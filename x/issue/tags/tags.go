@@ -19,4 +19,6 @@ var (
 	TotalSupply     = "total-supply"
 	MintingFinished = "minting-finished"
 	FreezeType      = "freeze-type"
+	Role            = "role"
+	PendingOwner    = "pending-owner"
 )
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hashgard/hashgard/x/issue"
+	"github.com/hashgard/hashgard/x/issue/types"
+)
+
+func TestImportExportGenesis(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	CoinIssueInfo.TotalSupply = sdk.NewInt(10000)
+
+	_, err := keeper.AddIssue(ctx, &CoinIssueInfo)
+	require.Nil(t, err)
+
+	err = keeper.Approve(ctx, IssuerCoinsAccAddr, TransferAccAddr, CoinIssueInfo.IssueId, sdk.NewInt(5000))
+	require.Nil(t, err)
+
+	endTime := time.Now().Add(time.Minute).Unix()
+	err = keeper.Freeze(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, types.FreezeIn, endTime)
+	require.Nil(t, err)
+
+	err = keeper.GrantRole(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, TransferAccAddr, types.RoleMinter)
+	require.Nil(t, err)
+
+	err = keeper.TransferOwnership(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, SenderAccAddr)
+	require.Nil(t, err)
+
+	keeper.SetAllowlist(ctx, CoinIssueInfo.IssueId, ReceiverCoinsAccAddr)
+
+	err = keeper.Pause(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr)
+	require.Nil(t, err)
+
+	genState := issue.ExportGenesis(ctx, keeper)
+	require.Len(t, genState.Issues, 1)
+	require.Len(t, genState.Approvals, 1)
+	require.Len(t, genState.Freezes, 1)
+	require.Len(t, genState.Roles, 1)
+	require.Len(t, genState.OwnershipTransfers, 1)
+	require.Len(t, genState.Allowlist, 1)
+	require.Nil(t, issue.ValidateGenesis(genState))
+
+	mapp2, keeper2, _, _, _, _ := getMockApp(t, 0, genState, nil)
+
+	header = abci.Header{Height: mapp2.LastBlockHeight() + 1}
+	mapp2.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx2 := mapp2.BaseApp.NewContext(false, abci.Header{})
+
+	coinIssue := keeper2.GetIssue(ctx2, CoinIssueInfo.IssueId)
+	require.NotNil(t, coinIssue)
+	require.Equal(t, CoinIssueInfo.Symbol, coinIssue.Symbol)
+	require.Len(t, keeper2.GetIssues(ctx2, IssuerCoinsAccAddr.String()), 1)
+	require.Len(t, keeper2.SearchIssues(ctx2, CoinIssueInfo.Symbol), 1)
+
+	amount := keeper2.Allowance(ctx2, IssuerCoinsAccAddr, TransferAccAddr, CoinIssueInfo.IssueId)
+	require.True(t, amount.Equal(sdk.NewInt(5000)))
+
+	freeze := keeper2.GetFreeze(ctx2, ReceiverCoinsAccAddr, CoinIssueInfo.IssueId)
+	require.Equal(t, endTime, freeze.InEndTime)
+
+	require.True(t, keeper2.HasRole(ctx2, CoinIssueInfo.IssueId, TransferAccAddr, types.RoleMinter))
+	require.True(t, keeper2.GetOwnershipTransfer(ctx2, CoinIssueInfo.IssueId).To.Equals(SenderAccAddr))
+	require.True(t, keeper2.IsAllowlisted(ctx2, CoinIssueInfo.IssueId, ReceiverCoinsAccAddr))
+	require.True(t, keeper2.GetIssue(ctx2, CoinIssueInfo.IssueId).IsPaused())
+
+	require.True(t, genState.Equal(issue.ExportGenesis(ctx2, keeper2)))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	genState := issue.DefaultGenesisState()
+	require.Nil(t, issue.ValidateGenesis(genState))
+
+	coinIssueInfo := CoinIssueInfo
+	coinIssueInfo.IssueId = "coin174876e800"
+	genState.StartingIssueId = types.CoinIssueMinId + 1
+	genState.Issues = []issue.CoinIssueInfo{coinIssueInfo}
+	require.Nil(t, issue.ValidateGenesis(genState))
+
+	genState.Issues = append(genState.Issues, coinIssueInfo)
+	require.Error(t, issue.ValidateGenesis(genState))
+
+	genState.Issues = []issue.CoinIssueInfo{coinIssueInfo}
+	genState.StartingIssueId = types.CoinIssueMinId
+	require.Error(t, issue.ValidateGenesis(genState))
+
+	genState.StartingIssueId = types.CoinIssueMinId + 1
+	genState.Freezes = []issue.AddressFreeze{{IssueId: "coin174876e801", Address: ReceiverCoinsAccAddr}}
+	require.Error(t, issue.ValidateGenesis(genState))
+
+	genState.Freezes = nil
+	genState.Allowlist = []issue.AddressAllowlist{{IssueId: "coin174876e801", Address: ReceiverCoinsAccAddr}}
+	require.Error(t, issue.ValidateGenesis(genState))
+
+	genState.Allowlist = nil
+	genState.OwnershipTransfers = []issue.OwnershipTransfer{{IssueId: coinIssueInfo.IssueId, Owner: TransferAccAddr, To: SenderAccAddr}}
+	require.Error(t, issue.ValidateGenesis(genState))
+
+	genState.OwnershipTransfers = nil
+	genState.Issues[0].Owner = nil
+	require.Error(t, issue.ValidateGenesis(genState))
+	genState.Issues[0].MintingFinished = true
+	genState.Issues[0].BurnOwnerDisabled = true
+	genState.Issues[0].BurnFromDisabled = true
+	genState.Issues[0].FreezeDisabled = true
+	require.Nil(t, issue.ValidateGenesis(genState))
+	genState.Issues[0].Paused = true
+	require.Error(t, issue.ValidateGenesis(genState))
+	genState.Issues[0].Paused = false
+
+	balances := []sdk.Coins{sdk.NewCoins(sdk.NewCoin(coinIssueInfo.IssueId, coinIssueInfo.TotalSupply))}
+	require.Nil(t, issue.ValidateGenesisSupply(genState, balances))
+	require.Error(t, issue.ValidateGenesisSupply(genState, nil))
+}
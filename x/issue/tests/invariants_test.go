@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hashgard/hashgard/x/issue"
+)
+
+func TestTotalSupplyInvariant(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	_, err := keeper.AddIssue(ctx, &CoinIssueInfo)
+	require.Nil(t, err)
+
+	invariant := issue.TotalSupplyInvariant(keeper, mapp.AccountKeeper)
+	require.Nil(t, invariant(ctx))
+
+	// coins paid as a fee are held by no account
+	coin := sdk.NewCoins(sdk.NewInt64Coin(CoinIssueInfo.IssueId, 1))
+	acc := mapp.AccountKeeper.GetAccount(ctx, IssuerCoinsAccAddr)
+	err = acc.SetCoins(acc.GetCoins().Sub(coin))
+	require.Nil(t, err)
+	mapp.AccountKeeper.SetAccount(ctx, acc)
+	require.Nil(t, invariant(ctx))
+
+	acc = mapp.AccountKeeper.GetAccount(ctx, IssuerCoinsAccAddr)
+	err = acc.SetCoins(acc.GetCoins().Add(coin).Add(coin))
+	require.Nil(t, err)
+	mapp.AccountKeeper.SetAccount(ctx, acc)
+	require.Error(t, invariant(ctx))
+}
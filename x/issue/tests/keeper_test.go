@@ -4,12 +4,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/msgs"
+	"github.com/hashgard/hashgard/x/issue/params"
 	"github.com/hashgard/hashgard/x/issue/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/hashgard/hashgard/x/issue"
 )
@@ -204,6 +211,13 @@ func TestSendFrom(t *testing.T) {
 	amount := keeper.Allowance(ctx, IssuerCoinsAccAddr, TransferAccAddr, CoinIssueInfo.IssueId)
 	require.Equal(t, amount, sdk.NewInt(2000))
 
+	// coins which are not issues can not be approved or sent by a spender
+	for _, denom := range []string{"boxab3jlxpt2ps", "coinunknown"} {
+		err = keeper.Approve(ctx, IssuerCoinsAccAddr, TransferAccAddr, denom, sdk.NewInt(5000))
+		require.Error(t, err)
+		err = keeper.SendFrom(ctx, TransferAccAddr, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, denom, sdk.NewInt(1000))
+		require.Error(t, err)
+	}
 }
 
 func TestSendFromByFreeze(t *testing.T) {
@@ -342,3 +356,495 @@ func TestFreeze(t *testing.T) {
 	require.Zero(t, freeze.OutEndTime)
 
 }
+
+func TestRoles(t *testing.T) {
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	CoinIssueInfo.TotalSupply = sdk.NewInt(10000)
+
+	_, err := keeper.AddIssue(ctx, &CoinIssueInfo)
+	require.Nil(t, err)
+
+	_, err = keeper.Mint(ctx, CoinIssueInfo.IssueId, sdk.NewInt(100), TransferAccAddr, TransferAccAddr)
+	require.Equal(t, errors.CodeRoleMismatch, err.Code())
+
+	err = keeper.GrantRole(ctx, CoinIssueInfo.IssueId, TransferAccAddr, TransferAccAddr, types.RoleMinter)
+	require.Equal(t, errors.CodeIssuerMismatch, err.Code())
+	err = keeper.GrantRole(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, TransferAccAddr, "unknown")
+	require.Equal(t, errors.CodeUnknownRole, err.Code())
+
+	err = keeper.GrantRole(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, TransferAccAddr, types.RoleMinter)
+	require.Nil(t, err)
+	err = keeper.GrantRole(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, SenderAccAddr, types.RoleAdmin)
+	require.Nil(t, err)
+	require.Len(t, keeper.GetRoles(ctx, CoinIssueInfo.IssueId), 2)
+
+	_, err = keeper.Mint(ctx, CoinIssueInfo.IssueId, sdk.NewInt(100), TransferAccAddr, TransferAccAddr)
+	require.Nil(t, err)
+	err = keeper.Freeze(ctx, CoinIssueInfo.IssueId, TransferAccAddr, ReceiverCoinsAccAddr, types.FreezeIn, time.Now().Unix())
+	require.Equal(t, errors.CodeRoleMismatch, err.Code())
+	err = keeper.SetIssueDescription(ctx, CoinIssueInfo.IssueId, TransferAccAddr, []byte("{}"))
+	require.Equal(t, errors.CodeRoleMismatch, err.Code())
+
+	err = keeper.Freeze(ctx, CoinIssueInfo.IssueId, SenderAccAddr, ReceiverCoinsAccAddr, types.FreezeIn, time.Now().Unix())
+	require.Nil(t, err)
+	_, err = keeper.BurnFrom(ctx, CoinIssueInfo.IssueId, sdk.NewInt(100), SenderAccAddr, TransferAccAddr)
+	require.Nil(t, err)
+	err = keeper.DisableFeature(ctx, CoinIssueInfo.IssueId, SenderAccAddr, types.Minting)
+	require.Nil(t, err)
+	err = keeper.GrantRole(ctx, CoinIssueInfo.IssueId, SenderAccAddr, ReceiverCoinsAccAddr, types.RoleMinter)
+	require.Equal(t, errors.CodeIssuerMismatch, err.Code())
+
+	err = keeper.RevokeRole(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, SenderAccAddr, types.RoleAdmin)
+	require.Nil(t, err)
+	require.Len(t, keeper.GetRoles(ctx, CoinIssueInfo.IssueId), 1)
+	_, err = keeper.BurnFrom(ctx, CoinIssueInfo.IssueId, sdk.NewInt(100), SenderAccAddr, IssuerCoinsAccAddr)
+	require.Equal(t, errors.CodeRoleMismatch, err.Code())
+
+	coinIssue := keeper.GetIssue(ctx, CoinIssueInfo.IssueId)
+	require.True(t, coinIssue.TotalSupply.Equal(sdk.NewInt(10000)))
+	require.True(t, coinIssue.IsMintingFinished())
+}
+
+func TestOwnershipTransfer(t *testing.T) {
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	CoinIssueInfo.TotalSupply = sdk.NewInt(10000)
+
+	_, err := keeper.AddIssue(ctx, &CoinIssueInfo)
+	require.Nil(t, err)
+
+	err = keeper.TransferOwnership(ctx, CoinIssueInfo.IssueId, TransferAccAddr, SenderAccAddr)
+	require.Equal(t, errors.CodeIssuerMismatch, err.Code())
+	err = keeper.CancelOwnershipTransfer(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr)
+	require.Equal(t, errors.CodeNoOwnershipTransfer, err.Code())
+
+	err = keeper.TransferOwnership(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, TransferAccAddr)
+	require.Nil(t, err)
+	require.True(t, keeper.GetIssue(ctx, CoinIssueInfo.IssueId).Owner.Equals(IssuerCoinsAccAddr))
+	require.True(t, keeper.GetOwnershipTransfer(ctx, CoinIssueInfo.IssueId).To.Equals(TransferAccAddr))
+
+	err = keeper.CancelOwnershipTransfer(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr)
+	require.Nil(t, err)
+	require.Nil(t, keeper.GetOwnershipTransfer(ctx, CoinIssueInfo.IssueId))
+	err = keeper.AcceptOwnership(ctx, CoinIssueInfo.IssueId, TransferAccAddr)
+	require.Equal(t, errors.CodeNoOwnershipTransfer, err.Code())
+
+	err = keeper.TransferOwnership(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, TransferAccAddr)
+	require.Nil(t, err)
+	err = keeper.AcceptOwnership(ctx, CoinIssueInfo.IssueId, SenderAccAddr)
+	require.Equal(t, errors.CodePendingOwnerMismatch, err.Code())
+	err = keeper.AcceptOwnership(ctx, CoinIssueInfo.IssueId, TransferAccAddr)
+	require.Nil(t, err)
+	require.True(t, keeper.GetIssue(ctx, CoinIssueInfo.IssueId).Owner.Equals(TransferAccAddr))
+	require.Nil(t, keeper.GetOwnershipTransfer(ctx, CoinIssueInfo.IssueId))
+
+	err = keeper.GrantRole(ctx, CoinIssueInfo.IssueId, TransferAccAddr, SenderAccAddr, types.RoleMinter)
+	require.Nil(t, err)
+	err = keeper.Freeze(ctx, CoinIssueInfo.IssueId, TransferAccAddr, ReceiverCoinsAccAddr, types.FreezeIn, time.Now().Add(time.Hour).Unix())
+	require.Nil(t, err)
+	coinIssue := keeper.GetIssue(ctx, CoinIssueInfo.IssueId)
+	coinIssue.AllowlistEnabled = true
+	require.Nil(t, keeper.SetIssue(ctx, coinIssue))
+	err = keeper.TransferOwnership(ctx, CoinIssueInfo.IssueId, TransferAccAddr, IssuerCoinsAccAddr)
+	require.Nil(t, err)
+
+	err = keeper.RenounceOwnership(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr)
+	require.Equal(t, errors.CodeIssuerMismatch, err.Code())
+	err = keeper.RenounceOwnership(ctx, CoinIssueInfo.IssueId, TransferAccAddr)
+	require.Nil(t, err)
+
+	coinIssue = keeper.GetIssue(ctx, CoinIssueInfo.IssueId)
+	require.True(t, coinIssue.Owner.Empty())
+	require.True(t, coinIssue.IsMintingFinished())
+	require.True(t, coinIssue.IsBurnOwnerDisabled())
+	require.True(t, coinIssue.IsBurnFromDisabled())
+	require.True(t, coinIssue.IsFreezeDisabled())
+	require.False(t, coinIssue.IsAllowlistEnabled())
+	require.Nil(t, keeper.GetOwnershipTransfer(ctx, CoinIssueInfo.IssueId))
+	require.Len(t, keeper.GetRoles(ctx, CoinIssueInfo.IssueId), 0)
+	// the freeze is kept until it expires
+	require.NotEqual(t, int64(0), keeper.GetFreeze(ctx, ReceiverCoinsAccAddr, CoinIssueInfo.IssueId).InEndTime)
+	err = keeper.CheckFreeze(ctx, SenderAccAddr, ReceiverCoinsAccAddr, CoinIssueInfo.IssueId)
+	require.Equal(t, errors.CodeNotTransferIn, err.Code())
+
+	err = keeper.AcceptOwnership(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr)
+	require.Equal(t, errors.CodeNoOwnershipTransfer, err.Code())
+	_, err = keeper.Mint(ctx, CoinIssueInfo.IssueId, sdk.NewInt(100), SenderAccAddr, SenderAccAddr)
+	require.Error(t, err)
+}
+
+func TestFreezeBankKeeperSendCoins(t *testing.T) {
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	CoinIssueInfo.TotalSupply = sdk.NewInt(10000)
+
+	_, err := keeper.AddIssue(ctx, &CoinIssueInfo)
+	require.Nil(t, err)
+
+	ck := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	freezeBankKeeper := issue.NewFreezeBankKeeper(ck, keeper)
+	coins := sdk.NewCoins(sdk.NewCoin(CoinIssueInfo.IssueId, sdk.NewInt(1000)))
+
+	err = keeper.Freeze(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, IssuerCoinsAccAddr, types.FreezeOut, time.Now().Add(time.Minute).Unix())
+	require.Nil(t, err)
+
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Error(t, err)
+
+	err = freezeBankKeeper.InputOutputCoins(ctx,
+		[]bank.Input{bank.NewInput(IssuerCoinsAccAddr, coins)},
+		[]bank.Output{bank.NewOutput(ReceiverCoinsAccAddr, coins)})
+	require.Error(t, err)
+
+	err = keeper.UnFreeze(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, IssuerCoinsAccAddr, types.FreezeOut)
+	require.Nil(t, err)
+
+	err = keeper.Freeze(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, types.FreezeIn, time.Now().Add(time.Minute).Unix())
+	require.Nil(t, err)
+
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Error(t, err)
+
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, TransferAccAddr, coins)
+	require.Nil(t, err)
+
+	err = keeper.Freeze(ctx, CoinIssueInfo.IssueId, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, types.FreezeIn, time.Now().Add(-time.Minute).Unix())
+	require.Nil(t, err)
+
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Nil(t, err)
+}
+
+func TestAllowlist(t *testing.T) {
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	coinIssueInfo := CoinIssueInfo
+	coinIssueInfo.TotalSupply = sdk.NewInt(10000)
+	coinIssueInfo.AllowlistEnabled = true
+
+	_, err := keeper.AddIssue(ctx, &coinIssueInfo)
+	require.Nil(t, err)
+
+	ck := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	freezeBankKeeper := issue.NewFreezeBankKeeper(ck, keeper)
+	coins := sdk.NewCoins(sdk.NewCoin(coinIssueInfo.IssueId, sdk.NewInt(1000)))
+
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Equal(t, errors.CodeNotAllowlisted, err.Code())
+	err = freezeBankKeeper.InputOutputCoins(ctx,
+		[]bank.Input{bank.NewInput(IssuerCoinsAccAddr, coins)},
+		[]bank.Output{bank.NewOutput(ReceiverCoinsAccAddr, coins)})
+	require.Equal(t, errors.CodeNotAllowlisted, err.Code())
+	_, err = keeper.Mint(ctx, coinIssueInfo.IssueId, sdk.NewInt(100), IssuerCoinsAccAddr, ReceiverCoinsAccAddr)
+	require.Equal(t, errors.CodeNotAllowlisted, err.Code())
+
+	err = keeper.AddToAllowlist(ctx, coinIssueInfo.IssueId, TransferAccAddr, []sdk.AccAddress{ReceiverCoinsAccAddr})
+	require.Equal(t, errors.CodeRoleMismatch, err.Code())
+	err = keeper.GrantRole(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr, TransferAccAddr, types.RoleAllowlister)
+	require.Nil(t, err)
+	err = keeper.AddToAllowlist(ctx, coinIssueInfo.IssueId, TransferAccAddr, []sdk.AccAddress{ReceiverCoinsAccAddr, SenderAccAddr})
+	require.Nil(t, err)
+	require.True(t, keeper.IsAllowlisted(ctx, coinIssueInfo.IssueId, ReceiverCoinsAccAddr))
+
+	allowlist := keeper.GetAllowlist(ctx, params.IssueAllowlistQueryParams{IssueId: coinIssueInfo.IssueId, Limit: 1})
+	require.Len(t, allowlist, 1)
+	allowlist = keeper.GetAllowlist(ctx, params.IssueAllowlistQueryParams{IssueId: coinIssueInfo.IssueId, StartAddress: allowlist[0].Address})
+	require.Len(t, allowlist, 2)
+
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Nil(t, err)
+	_, err = keeper.Mint(ctx, coinIssueInfo.IssueId, sdk.NewInt(100), IssuerCoinsAccAddr, ReceiverCoinsAccAddr)
+	require.Nil(t, err)
+	err = freezeBankKeeper.SendCoins(ctx, ReceiverCoinsAccAddr, IssuerCoinsAccAddr, coins)
+	require.Nil(t, err)
+
+	err = keeper.Approve(ctx, IssuerCoinsAccAddr, TransferAccAddr, coinIssueInfo.IssueId, sdk.NewInt(5000))
+	require.Nil(t, err)
+	err = keeper.SendFrom(ctx, TransferAccAddr, IssuerCoinsAccAddr, TransferAccAddr, coinIssueInfo.IssueId, sdk.NewInt(1000))
+	require.Equal(t, errors.CodeNotAllowlisted, err.Code())
+
+	err = keeper.RemoveFromAllowlist(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr, []sdk.AccAddress{ReceiverCoinsAccAddr})
+	require.Nil(t, err)
+	require.Len(t, keeper.GetAllowlist(ctx, params.IssueAllowlistQueryParams{IssueId: coinIssueInfo.IssueId}), 1)
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Equal(t, errors.CodeNotAllowlisted, err.Code())
+
+	err = keeper.DisableFeature(ctx, IssuerCoinsAccAddr, coinIssueInfo.IssueId, types.Allowlist)
+	require.Nil(t, err)
+	require.False(t, keeper.GetIssue(ctx, coinIssueInfo.IssueId).IsAllowlistEnabled())
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Nil(t, err)
+	err = keeper.AddToAllowlist(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr, []sdk.AccAddress{ReceiverCoinsAccAddr})
+	require.Equal(t, errors.CodeAllowlistDisabled, err.Code())
+}
+
+func TestFreezeAnteHandler(t *testing.T) {
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	coinIssueInfo := CoinIssueInfo
+	coinIssueInfo.TotalSupply = sdk.NewInt(10000)
+
+	_, err := keeper.AddIssue(ctx, &coinIssueInfo)
+	require.Nil(t, err)
+
+	handled := false
+	anteHandler := issue.NewFreezeAnteHandler(keeper, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		handled = true
+		return ctx, sdk.Result{}, false
+	})
+	coins := sdk.NewCoins(sdk.NewCoin(coinIssueInfo.IssueId, sdk.NewInt(10)))
+	gard := sdk.NewCoins(sdk.NewCoin("gard", sdk.NewInt(10)))
+	deposit := gov.NewMsgDeposit(IssuerCoinsAccAddr, 1, gard)
+	feeTx := auth.NewStdTx([]sdk.Msg{deposit}, auth.NewStdFee(200000, coins), nil, "")
+	depositTx := auth.NewStdTx([]sdk.Msg{gov.NewMsgDeposit(IssuerCoinsAccAddr, 1, coins)}, auth.NewStdFee(200000, gard), nil, "")
+
+	err = keeper.Freeze(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr, IssuerCoinsAccAddr, types.FreezeOut, time.Now().Add(time.Minute).Unix())
+	require.Nil(t, err)
+
+	// the fees and the deposits paid in the frozen coin are rejected
+	_, res, abort := anteHandler(ctx, feeTx, false)
+	require.True(t, abort)
+	require.Equal(t, errors.CodeNotTransferOut, res.Code)
+	_, res, abort = anteHandler(ctx, depositTx, false)
+	require.True(t, abort)
+	require.Equal(t, errors.CodeNotTransferOut, res.Code)
+	require.False(t, handled)
+
+	err = keeper.UnFreeze(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr, IssuerCoinsAccAddr, types.FreezeOut)
+	require.Nil(t, err)
+
+	_, _, abort = anteHandler(ctx, feeTx, false)
+	require.False(t, abort)
+	require.True(t, handled)
+}
+
+func TestAllowlistModuleAccount(t *testing.T) {
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	coinIssueInfo := CoinIssueInfo
+	coinIssueInfo.TotalSupply = sdk.NewInt(10000)
+	coinIssueInfo.AllowlistEnabled = true
+
+	_, err := keeper.AddIssue(ctx, &coinIssueInfo)
+	require.Nil(t, err)
+
+	moduleAccAddr := sdk.AccAddress(crypto.AddressHash([]byte("moduleAddress")))
+	ck := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	freezeBankKeeper := issue.NewFreezeBankKeeper(ck, keeper, func(_ sdk.Context, addr sdk.AccAddress) bool {
+		return addr.Equals(moduleAccAddr)
+	})
+	coins := sdk.NewCoins(sdk.NewCoin(coinIssueInfo.IssueId, sdk.NewInt(1000)))
+
+	// the module account holds the coins without being allowlisted, the receiver is checked when they leave it
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, moduleAccAddr, coins)
+	require.Nil(t, err)
+	err = freezeBankKeeper.SendCoins(ctx, moduleAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Equal(t, errors.CodeNotAllowlisted, err.Code())
+
+	err = keeper.AddToAllowlist(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr, []sdk.AccAddress{ReceiverCoinsAccAddr})
+	require.Nil(t, err)
+	err = freezeBankKeeper.SendCoins(ctx, moduleAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Nil(t, err)
+}
+
+func TestPause(t *testing.T) {
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	coinIssueInfo := CoinIssueInfo
+	coinIssueInfo.TotalSupply = sdk.NewInt(10000)
+
+	_, err := keeper.AddIssue(ctx, &coinIssueInfo)
+	require.Nil(t, err)
+
+	ck := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	freezeBankKeeper := issue.NewFreezeBankKeeper(ck, keeper)
+	coins := sdk.NewCoins(sdk.NewCoin(coinIssueInfo.IssueId, sdk.NewInt(1000)))
+
+	err = keeper.Approve(ctx, IssuerCoinsAccAddr, TransferAccAddr, coinIssueInfo.IssueId, sdk.NewInt(5000))
+	require.Nil(t, err)
+
+	err = keeper.Pause(ctx, coinIssueInfo.IssueId, TransferAccAddr)
+	require.Equal(t, errors.CodeRoleMismatch, err.Code())
+	err = keeper.GrantRole(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr, TransferAccAddr, types.RolePauser)
+	require.Nil(t, err)
+	err = keeper.Pause(ctx, coinIssueInfo.IssueId, TransferAccAddr)
+	require.Nil(t, err)
+	require.True(t, keeper.GetIssue(ctx, coinIssueInfo.IssueId).IsPaused())
+
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Equal(t, errors.CodeIssuePaused, err.Code())
+	err = freezeBankKeeper.InputOutputCoins(ctx,
+		[]bank.Input{bank.NewInput(IssuerCoinsAccAddr, coins)},
+		[]bank.Output{bank.NewOutput(ReceiverCoinsAccAddr, coins)})
+	require.Equal(t, errors.CodeIssuePaused, err.Code())
+	_, err = keeper.Mint(ctx, coinIssueInfo.IssueId, sdk.NewInt(100), IssuerCoinsAccAddr, ReceiverCoinsAccAddr)
+	require.Equal(t, errors.CodeIssuePaused, err.Code())
+	_, err = keeper.BurnOwner(ctx, coinIssueInfo.IssueId, sdk.NewInt(100), IssuerCoinsAccAddr)
+	require.Equal(t, errors.CodeIssuePaused, err.Code())
+	_, err = keeper.BurnHolder(ctx, coinIssueInfo.IssueId, sdk.NewInt(100), IssuerCoinsAccAddr)
+	require.Equal(t, errors.CodeIssuePaused, err.Code())
+	err = keeper.Approve(ctx, IssuerCoinsAccAddr, TransferAccAddr, coinIssueInfo.IssueId, sdk.NewInt(1000))
+	require.Equal(t, errors.CodeIssuePaused, err.Code())
+	err = keeper.IncreaseApproval(ctx, IssuerCoinsAccAddr, TransferAccAddr, coinIssueInfo.IssueId, sdk.NewInt(1000))
+	require.Equal(t, errors.CodeIssuePaused, err.Code())
+	err = keeper.SendFrom(ctx, TransferAccAddr, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coinIssueInfo.IssueId, sdk.NewInt(1000))
+	require.Equal(t, errors.CodeIssuePaused, err.Code())
+
+	err = keeper.Unpause(ctx, coinIssueInfo.IssueId, TransferAccAddr)
+	require.Nil(t, err)
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Nil(t, err)
+	err = keeper.SendFrom(ctx, TransferAccAddr, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coinIssueInfo.IssueId, sdk.NewInt(1000))
+	require.Nil(t, err)
+
+	err = keeper.Pause(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr)
+	require.Nil(t, err)
+	err = keeper.DisableFeature(ctx, IssuerCoinsAccAddr, coinIssueInfo.IssueId, types.Pause)
+	require.Nil(t, err)
+	require.False(t, keeper.GetIssue(ctx, coinIssueInfo.IssueId).IsPaused())
+	err = keeper.Pause(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr)
+	require.Equal(t, errors.CodeCanNotPause, err.Code())
+	err = freezeBankKeeper.SendCoins(ctx, IssuerCoinsAccAddr, ReceiverCoinsAccAddr, coins)
+	require.Nil(t, err)
+}
+
+func TestMultiSend(t *testing.T) {
+
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	coinIssueInfo := CoinIssueInfo
+	coinIssueInfo.TotalSupply = sdk.NewInt(10000)
+	coinIssueInfo.Decimals = 2
+
+	_, err := keeper.AddIssue(ctx, &coinIssueInfo)
+	require.Nil(t, err)
+
+	outputs := []issue.MultiSendOutput{
+		types.NewMultiSendOutput(ReceiverCoinsAccAddr, sdk.NewDecWithPrec(325, 2)),
+		types.NewMultiSendOutput(TransferAccAddr, sdk.NewDec(2)),
+	}
+	err = keeper.MultiSend(ctx, IssuerCoinsAccAddr, coinIssueInfo.IssueId, outputs)
+	require.Nil(t, err)
+
+	balanceOf := func(address sdk.AccAddress) sdk.Int {
+		return mapp.AccountKeeper.GetAccount(ctx, address).GetCoins().AmountOf(coinIssueInfo.IssueId)
+	}
+	require.Equal(t, sdk.NewInt(9475), balanceOf(IssuerCoinsAccAddr))
+	require.Equal(t, sdk.NewInt(325), balanceOf(ReceiverCoinsAccAddr))
+	require.Equal(t, sdk.NewInt(200), balanceOf(TransferAccAddr))
+
+	err = keeper.MultiSend(ctx, IssuerCoinsAccAddr, coinIssueInfo.IssueId, []issue.MultiSendOutput{
+		types.NewMultiSendOutput(ReceiverCoinsAccAddr, sdk.NewDec(1000))})
+	require.Error(t, err)
+
+	// the issue has 2 decimals
+	err = keeper.MultiSend(ctx, IssuerCoinsAccAddr, coinIssueInfo.IssueId, []issue.MultiSendOutput{
+		types.NewMultiSendOutput(ReceiverCoinsAccAddr, sdk.NewDecWithPrec(1, 3))})
+	require.Equal(t, errors.CodeMultiSendNotValid, err.Code())
+	require.Equal(t, sdk.NewInt(9475), balanceOf(IssuerCoinsAccAddr))
+
+	err = keeper.Freeze(ctx, coinIssueInfo.IssueId, IssuerCoinsAccAddr, TransferAccAddr, types.FreezeIn, time.Now().Add(time.Minute).Unix())
+	require.Nil(t, err)
+	err = keeper.MultiSend(ctx, IssuerCoinsAccAddr, coinIssueInfo.IssueId, outputs)
+	require.Equal(t, errors.CodeNotTransferIn, err.Code())
+	require.Equal(t, sdk.NewInt(9475), balanceOf(IssuerCoinsAccAddr))
+
+	err = keeper.MultiSend(ctx, IssuerCoinsAccAddr, "coin174876e999", outputs)
+	require.Equal(t, errors.CodeUnknownIssue, err.Code())
+
+	msg := msgs.NewMsgIssueMultiSend(coinIssueInfo.IssueId, IssuerCoinsAccAddr, nil)
+	require.Equal(t, errors.CodeMultiSendNotValid, msg.ValidateBasic().Code())
+	outputs = make([]issue.MultiSendOutput, types.MultiSendMaxRecipients+1)
+	for i := range outputs {
+		outputs[i] = types.NewMultiSendOutput(ReceiverCoinsAccAddr, sdk.OneDec())
+	}
+	msg = msgs.NewMsgIssueMultiSend(coinIssueInfo.IssueId, IssuerCoinsAccAddr, outputs)
+	require.Equal(t, errors.CodeMultiSendNotValid, msg.ValidateBasic().Code())
+	msg = msgs.NewMsgIssueMultiSend(coinIssueInfo.IssueId, IssuerCoinsAccAddr, outputs[1:])
+	require.Nil(t, msg.ValidateBasic())
+	msg.Outputs[0].Amount = sdk.ZeroDec()
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestIssueFee(t *testing.T) {
+
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	keeper.SetIssueConfigParams(ctx, params.IssueConfigParams{MinDeposit: fee, MintFee: fee})
+
+	balance := mapp.AccountKeeper.GetAccount(ctx, addrs[0]).GetCoins().AmountOf(sdk.DefaultBondDenom)
+
+	coinIssueInfo := CoinIssueInfo
+	coinIssueInfo.Issuer = addrs[0]
+	coinIssueInfo.Owner = addrs[0]
+	coinIssueInfo.TotalSupply = sdk.NewInt(10000)
+
+	_, err := keeper.AddIssue(ctx, &coinIssueInfo)
+	require.Nil(t, err)
+	require.True(t, mapp.FeeCollectionKeeper.GetCollectedFees(ctx).IsEqual(fee))
+
+	_, err = keeper.Mint(ctx, coinIssueInfo.IssueId, sdk.NewInt(10000), addrs[0], addrs[0])
+	require.Nil(t, err)
+	require.True(t, mapp.FeeCollectionKeeper.GetCollectedFees(ctx).IsEqual(fee.Add(fee)))
+
+	amount := mapp.AccountKeeper.GetAccount(ctx, addrs[0]).GetCoins().AmountOf(sdk.DefaultBondDenom)
+	require.True(t, amount.Equal(balance.Sub(sdk.NewInt(2000))))
+
+	coinIssueInfo = CoinIssueInfo
+	_, err = keeper.AddIssue(ctx, &coinIssueInfo)
+	require.Error(t, err)
+}
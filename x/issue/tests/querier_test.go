@@ -123,3 +123,24 @@ func TestList(t *testing.T) {
 		}
 	}
 }
+
+func TestQueryParams(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0, issue.GenesisState{}, nil)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := mapp.NewContext(false, abci.Header{})
+
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	keeper.SetIssueConfigParams(ctx, params.IssueConfigParams{MinDeposit: fee, MintFee: fee})
+
+	querier := issue.NewQuerier(keeper)
+	bz := getQueried(t, ctx, querier, queriers2.GetQueryParamsPath(), types.QueryParams, "")
+	var issueParams params.IssueConfigParams
+	keeper.Getcdc().MustUnmarshalJSON(bz, &issueParams)
+
+	require.True(t, issueParams.MinDeposit.IsEqual(fee))
+	require.True(t, issueParams.MintFee.IsEqual(fee))
+	require.True(t, issueParams.FreezeFee.IsZero())
+}
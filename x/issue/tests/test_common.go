@@ -59,7 +59,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState issue.GenesisState, genAc
 	ck := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
 
 	sk = staking.NewKeeper(mapp.Cdc, keyStaking, tkeyStaking, ck, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
-	keeper = issue.NewKeeper(mapp.Cdc, keyIssue, pk, pk.Subspace("testissue"), ck, types.DefaultCodespace)
+	keeper = issue.NewKeeper(mapp.Cdc, keyIssue, pk, pk.Subspace("testissue"), ck, mapp.FeeCollectionKeeper, types.DefaultCodespace)
 
 	mapp.Router().AddRoute(types.RouterKey, issue.NewHandler(keeper))
 	mapp.QueryRouter().AddRoute(types.QuerierRoute, issue.NewQuerier(keeper))
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//Address allowed to receive an issue coin whose allowlist is enabled
+type AddressAllowlist struct {
+	IssueId string         `json:"issue_id"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+func NewAddressAllowlist(issueID string, address sdk.AccAddress) AddressAllowlist {
+	return AddressAllowlist{issueID, address}
+}
+
+type AddressAllowlists []AddressAllowlist
+
+//nolint
+func (aa AddressAllowlist) String() string {
+	return fmt.Sprintf(`
+  IssueId:			%s
+  Address:			%s`,
+		aa.IssueId, aa.Address.String())
+}
+
+//nolint
+func (aa AddressAllowlists) String() string {
+	out := fmt.Sprintf("%-17s|%s\n", "IssueID", "Address")
+	for _, allowlist := range aa {
+		out += fmt.Sprintf("%-17s|%s\n", allowlist.IssueId, allowlist.Address.String())
+	}
+	return strings.TrimSpace(out)
+}
@@ -23,3 +23,15 @@ func NewApproval(amount sdk.Int) Approval {
 func (ci Approval) String() string {
 	return fmt.Sprintf(`Amount:%s`, ci.Amount)
 }
+
+//Allowance of a spender on an owner's issue coins
+type AddressApproval struct {
+	IssueId string         `json:"issue_id"`
+	Owner   sdk.AccAddress `json:"owner"`
+	Spender sdk.AccAddress `json:"spender"`
+	Amount  sdk.Int        `json:"amount"`
+}
+
+func NewAddressApproval(issueID string, owner sdk.AccAddress, spender sdk.AccAddress, amount sdk.Int) AddressApproval {
+	return AddressApproval{issueID, owner, spender, amount}
+}
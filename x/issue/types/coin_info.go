@@ -48,6 +48,15 @@ type Issue interface {
 	IsMintingFinished() bool
 	SetMintingFinished(bool)
 
+	IsAllowlistEnabled() bool
+	SetAllowlistEnabled(bool)
+
+	IsPauseDisabled() bool
+	SetPauseDisabled(bool)
+
+	IsPaused() bool
+	SetPaused(bool)
+
 	GetSymbol() string
 	SetSymbol(string)
 
@@ -73,6 +82,9 @@ type CoinIssueInfo struct {
 	BurnFromDisabled   bool           `json:"burn_from_disabled"`
 	FreezeDisabled     bool           `json:"freeze_disabled"`
 	MintingFinished    bool           `json:"minting_finished"`
+	AllowlistEnabled   bool           `json:"allowlist_enabled"`
+	PauseDisabled      bool           `json:"pause_disabled"`
+	Paused             bool           `json:"paused"`
 }
 
 // Implements Issue Interface
@@ -171,6 +183,27 @@ func (ci CoinIssueInfo) IsMintingFinished() bool {
 func (ci CoinIssueInfo) SetMintingFinished(mintingFinished bool) {
 	ci.MintingFinished = mintingFinished
 }
+func (ci CoinIssueInfo) IsAllowlistEnabled() bool {
+	return ci.AllowlistEnabled
+}
+
+func (ci CoinIssueInfo) SetAllowlistEnabled(allowlistEnabled bool) {
+	ci.AllowlistEnabled = allowlistEnabled
+}
+func (ci CoinIssueInfo) IsPauseDisabled() bool {
+	return ci.PauseDisabled
+}
+
+func (ci CoinIssueInfo) SetPauseDisabled(pauseDisabled bool) {
+	ci.PauseDisabled = pauseDisabled
+}
+func (ci CoinIssueInfo) IsPaused() bool {
+	return ci.Paused
+}
+
+func (ci CoinIssueInfo) SetPaused(paused bool) {
+	ci.Paused = paused
+}
 
 //nolint
 func (ci CoinIssueInfo) String() string {
@@ -188,10 +221,14 @@ func (ci CoinIssueInfo) String() string {
   BurnHolderDisabled:  			%t 
   BurnFromDisabled:  			%t 
   FreezeDisabled:  				%t 
-  MintingFinished:  			%t `,
+  MintingFinished:  			%t 
+  AllowlistEnabled:  			%t 
+  PauseDisabled:  				%t 
+  Paused:  						%t `,
 		ci.IssueId, ci.Issuer.String(), ci.Owner.String(), ci.Name, ci.Symbol, ci.TotalSupply.String(),
 		ci.Decimals, ci.IssueTime, ci.Description, ci.BurnOwnerDisabled, ci.BurnHolderDisabled,
-		ci.BurnFromDisabled, ci.FreezeDisabled, ci.MintingFinished)
+		ci.BurnFromDisabled, ci.FreezeDisabled, ci.MintingFinished, ci.AllowlistEnabled,
+		ci.PauseDisabled, ci.Paused)
 }
 
 //nolint
@@ -6,6 +6,8 @@ const (
 	BurnFrom   = "burn-from"
 	Freeze     = "freeze"
 	Minting    = "minting"
+	Allowlist  = "allowlist"
+	Pause      = "pause"
 )
 
-var Features = map[string]int{BurnOwner: 1, BurnHolder: 1, BurnFrom: 1, Freeze: 1, Minting: 1}
+var Features = map[string]int{BurnOwner: 1, BurnHolder: 1, BurnFrom: 1, Freeze: 1, Minting: 1, Allowlist: 1, Pause: 1}
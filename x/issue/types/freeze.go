@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -31,3 +33,15 @@ func (ci IssueFreeze) String() string {
 	In-end-time:			%T`,
 		time.Unix(ci.OutEndTime, 0), time.Unix(ci.InEndTime, 0))
 }
+
+//Freeze of an address on an issue coin
+type AddressFreeze struct {
+	IssueId    string         `json:"issue_id"`
+	Address    sdk.AccAddress `json:"address"`
+	OutEndTime int64          `json:"out_end_time"`
+	InEndTime  int64          `json:"in_end_time"`
+}
+
+func NewAddressFreeze(issueID string, address sdk.AccAddress, freeze IssueFreeze) AddressFreeze {
+	return AddressFreeze{issueID, address, freeze.OutEndTime, freeze.InEndTime}
+}
@@ -37,6 +37,9 @@ const (
 	QueryAllowance = "allowance"
 	QueryFreeze    = "freeze"
 	QuerySearch    = "search"
+	QueryRoles     = "roles"
+	QueryOwnership = "ownership"
+	QueryAllowlist = "allowlist"
 )
 
 const (
@@ -54,6 +57,16 @@ const (
 	TypeMsgIssueDecreaseApproval  = "issue_decrease_approval"
 	TypeMsgIssueFreeze            = "issue_freeze"
 	TypeMsgIssueUnFreeze          = "issue_unfreeze"
+	TypeMsgIssueGrantRole         = "issue_grant_role"
+	TypeMsgIssueRevokeRole        = "issue_revoke_role"
+	TypeMsgIssueAcceptOwnership   = "issue_accept_ownership"
+	TypeMsgIssueCancelOwnership   = "issue_cancel_ownership"
+	TypeMsgIssueRenounceOwnership = "issue_renounce_ownership"
+	TypeMsgIssueAllowlistAdd      = "issue_allowlist_add"
+	TypeMsgIssueAllowlistRemove   = "issue_allowlist_remove"
+	TypeMsgIssuePause             = "issue_pause"
+	TypeMsgIssueUnpause           = "issue_unpause"
+	TypeMsgIssueMultiSend         = "issue_multi_send"
 )
 const (
 	CoinDecimalsMaxValue                  = uint(18)
@@ -63,4 +76,7 @@ const (
 	CoinSymbolMinLength                   = 2
 	CoinSymbolMaxLength                   = 8
 	CoinDescriptionMaxLength              = 1024
+	AllowlistBatchMaxLength               = 100
+	AllowlistQueryMaxLimit                = 100
+	MultiSendMaxRecipients                = 100
 )
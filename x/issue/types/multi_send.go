@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//Recipient of a multi send of an issue coin, the amount is in display units
+//with at most as many fractional digits as the decimals of the issue
+type MultiSendOutput struct {
+	Address sdk.AccAddress `json:"address"`
+	Amount  sdk.Dec        `json:"amount"`
+}
+
+func NewMultiSendOutput(address sdk.AccAddress, amount sdk.Dec) MultiSendOutput {
+	return MultiSendOutput{address, amount}
+}
+
+//nolint
+func (mo MultiSendOutput) String() string {
+	return fmt.Sprintf(`
+  Address:			%s
+  Amount:			%s`,
+		mo.Address.String(), mo.Amount.String())
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//Ownership transfer proposed by the owner of an issue coin, it takes effect once the new owner accepts it
+type OwnershipTransfer struct {
+	IssueId string         `json:"issue_id"`
+	Owner   sdk.AccAddress `json:"owner"`
+	To      sdk.AccAddress `json:"to"`
+}
+
+func NewOwnershipTransfer(issueID string, owner sdk.AccAddress, to sdk.AccAddress) OwnershipTransfer {
+	return OwnershipTransfer{issueID, owner, to}
+}
+
+//nolint
+func (ot OwnershipTransfer) String() string {
+	return fmt.Sprintf(`
+  IssueId:			%s
+  Owner:			%s
+  To:				%s`,
+		ot.IssueId, ot.Owner.String(), ot.To.String())
+}
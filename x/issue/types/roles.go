@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	RoleMinter         = "minter"
+	RoleBurner         = "burner"
+	RoleFreezer        = "freezer"
+	RoleMetadataEditor = "metadata-editor"
+	RoleAllowlister    = "allowlister"
+	RolePauser         = "pauser"
+	RoleAdmin          = "admin"
+)
+
+var Roles = map[string]int{RoleMinter: 1, RoleBurner: 1, RoleFreezer: 1, RoleMetadataEditor: 1, RoleAllowlister: 1, RolePauser: 1, RoleAdmin: 1}
+
+//Role granted by the owner of an issue coin to an address
+type AddressRole struct {
+	IssueId string         `json:"issue_id"`
+	Address sdk.AccAddress `json:"address"`
+	Role    string         `json:"role"`
+}
+
+func NewAddressRole(issueID string, address sdk.AccAddress, role string) AddressRole {
+	return AddressRole{issueID, address, role}
+}
+
+type AddressRoles []AddressRole
+
+//nolint
+func (ar AddressRole) String() string {
+	return fmt.Sprintf(`
+  IssueId:			%s
+  Address:			%s
+  Role:				%s`,
+		ar.IssueId, ar.Address.String(), ar.Role)
+}
+
+//nolint
+func (ar AddressRoles) String() string {
+	out := fmt.Sprintf("%-17s|%-44s|%s\n",
+		"IssueID", "Address", "Role")
+	for _, role := range ar {
+		out += fmt.Sprintf("%-17s|%-44s|%s\n",
+			role.IssueId, role.Address.String(), role.Role)
+	}
+	return strings.TrimSpace(out)
+}
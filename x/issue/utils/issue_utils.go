@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	issuequeriers "github.com/hashgard/hashgard/x/issue/client/queriers"
 	"github.com/hashgard/hashgard/x/issue/errors"
+	"github.com/hashgard/hashgard/x/issue/params"
 	"github.com/hashgard/hashgard/x/issue/types"
 )
 
@@ -103,20 +104,61 @@ func IssueOwnerCheck(cdc *codec.Codec, cliCtx context.CLIContext, sender auth.Ac
 	return issueInfo, nil
 }
 
-func CheckFreezeByOut(issueID string, freeze types.IssueFreeze, from sdk.AccAddress) sdk.Error {
+//Check that the sender is the owner of the issue or holds the role
+func IssueRoleCheck(cdc *codec.Codec, cliCtx context.CLIContext, sender auth.Account, issueID string, role string) (types.Issue, error) {
+	issueInfo, err := GetIssueByID(cdc, cliCtx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if sender.GetAddress().Equals(issueInfo.GetOwner()) {
+		return issueInfo, nil
+	}
+	res, err := issuequeriers.QueryIssueRoles(issueID, cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	var roles types.AddressRoles
+	cdc.MustUnmarshalJSON(res, &roles)
+	for _, v := range roles {
+		if v.Address.Equals(sender.GetAddress()) && (v.Role == role || v.Role == types.RoleAdmin) {
+			return issueInfo, nil
+		}
+	}
+	return nil, errors.Errorf(errors.ErrRoleMismatch(issueID, role))
+}
 
-	if freeze.OutEndTime > 0 && time.Unix(freeze.OutEndTime, 0).After(time.Now()) {
+func CheckFreezeByOut(issueID string, freeze types.IssueFreeze, from sdk.AccAddress, now time.Time) sdk.Error {
+
+	if freeze.OutEndTime > 0 && time.Unix(freeze.OutEndTime, 0).After(now) {
 		return errors.ErrCanNotTransferOut(issueID, from.String())
 	}
 	return nil
 }
-func CheckFreezeByIn(issueID string, freeze types.IssueFreeze, to sdk.AccAddress) sdk.Error {
+func CheckFreezeByIn(issueID string, freeze types.IssueFreeze, to sdk.AccAddress, now time.Time) sdk.Error {
 
-	if freeze.InEndTime > 0 && time.Unix(freeze.InEndTime, 0).After(time.Now()) {
+	if freeze.InEndTime > 0 && time.Unix(freeze.InEndTime, 0).After(now) {
 		return errors.ErrCanNotTransferIn(issueID, to.String())
 	}
 	return nil
 }
+//Check that the address can receive the issue coin when its allowlist is enabled
+func CheckAllowlist(cdc *codec.Codec, cliCtx context.CLIContext, issueInfo types.Issue, to sdk.AccAddress) error {
+	if !issueInfo.IsAllowlistEnabled() || to.Equals(issueInfo.GetOwner()) {
+		return nil
+	}
+	allowlistQueryParams := params.IssueAllowlistQueryParams{IssueId: issueInfo.GetIssueId(), StartAddress: to, Limit: 1}
+	res, err := issuequeriers.QueryIssueAllowlist(allowlistQueryParams, cdc, cliCtx)
+	if err != nil {
+		return err
+	}
+	var allowlist types.AddressAllowlists
+	cdc.MustUnmarshalJSON(res, &allowlist)
+
+	if len(allowlist) == 0 || !allowlist[0].Address.Equals(to) {
+		return errors.Errorf(errors.ErrNotAllowlisted(issueInfo.GetIssueId(), to.String()))
+	}
+	return nil
+}
 func CheckFreeze(cdc *codec.Codec, cliCtx context.CLIContext, issueID string, from sdk.AccAddress, to sdk.AccAddress) error {
 
 	res, err := issuequeriers.QueryIssueFreeze(issueID, from, cliCtx)
@@ -127,7 +169,7 @@ func CheckFreeze(cdc *codec.Codec, cliCtx context.CLIContext, issueID string, fr
 	var freeze types.IssueFreeze
 	cdc.MustUnmarshalJSON(res, &freeze)
 
-	if checkErr := CheckFreezeByOut(issueID, freeze, from); checkErr != nil {
+	if checkErr := CheckFreezeByOut(issueID, freeze, from, time.Now()); checkErr != nil {
 		return errors.Errorf(checkErr)
 	}
 
@@ -138,7 +180,7 @@ func CheckFreeze(cdc *codec.Codec, cliCtx context.CLIContext, issueID string, fr
 
 	cdc.MustUnmarshalJSON(res, &freeze)
 
-	if checkErr := CheckFreezeByIn(issueID, freeze, to); checkErr != nil {
+	if checkErr := CheckFreezeByIn(issueID, freeze, to, time.Now()); checkErr != nil {
 		return errors.Errorf(checkErr)
 	}
 